@@ -0,0 +1,55 @@
+/*
+Copyright 2024 cuisongliu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestToK8sAffinity(t *testing.T) {
+	if affinity := (*AffinitySpec)(nil).ToK8sAffinity(nil); affinity != nil {
+		t.Errorf("ToK8sAffinity() of no affinity = %+v, want nil", affinity)
+	}
+	podLabels := map[string]string{"app.kubernetes.io/role": "broker"}
+	affinity := (&AffinitySpec{
+		NodeAffinity: &NodeAffinity{
+			Type:         "hard",
+			NodeSelector: []NodeSelector{{Key: "node-role.kubernetes.io/kafka", Values: []string{"true"}}},
+		},
+		PodAntiAffinity: &PodAntiAffinity{Type: "soft", Weight: 40},
+		PodAffinity:     &PodAffinity{Type: "hard"},
+	}).ToK8sAffinity(podLabels)
+
+	required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if required == nil || len(required.NodeSelectorTerms) != 1 {
+		t.Fatalf("the required node affinity = %+v", required)
+	}
+	expr := required.NodeSelectorTerms[0].MatchExpressions
+	if len(expr) != 1 || expr[0].Key != "node-role.kubernetes.io/kafka" || expr[0].Operator != corev1.NodeSelectorOpIn {
+		t.Errorf("the node selector requirements = %+v", expr)
+	}
+	preferred := affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+	if len(preferred) != 1 || preferred[0].Weight != 40 || preferred[0].PodAffinityTerm.TopologyKey != corev1.LabelHostname ||
+		preferred[0].PodAffinityTerm.LabelSelector.MatchLabels["app.kubernetes.io/role"] != "broker" {
+		t.Errorf("the preferred pod anti-affinity = %+v", preferred)
+	}
+	if terms := affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution; len(terms) != 1 {
+		t.Errorf("the required pod affinity = %+v", terms)
+	}
+}
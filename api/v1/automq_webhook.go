@@ -0,0 +1,794 @@
+/*
+Copyright 2024 cuisongliu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cuisongliu/automq-operator/defaults"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var automqlog = logf.Log.WithName("automq-resource")
+
+func (r *AutoMQ) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	w := &automqWebhook{Client: mgr.GetAPIReader()}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete()
+}
+
+// automqWebhook defaults and validates the AutoMQ. The client reads the other resources of the cluster from
+// the API server directly, so the services and the secrets are not cached by the operator.
+type automqWebhook struct {
+	Client client.Reader
+}
+
+//+kubebuilder:webhook:path=/mutate-infra-cuisongliu-github-com-v1-automq,mutating=true,failurePolicy=fail,sideEffects=None,groups=infra.cuisongliu.github.com,resources=automqs,verbs=create;update,versions=v1,name=mautomq.kb.io,admissionReviewVersions=v1
+
+var _ admission.CustomDefaulter = &automqWebhook{}
+
+// Default implements admission.CustomDefaulter so a webhook will be registered for the type
+func (w *automqWebhook) Default(_ context.Context, obj runtime.Object) error {
+	r, ok := obj.(*AutoMQ)
+	if !ok {
+		return fmt.Errorf("expected an AutoMQ but got a %T", obj)
+	}
+	automqlog.Info("default", "name", r.Name)
+	if r.Spec.Image == "" {
+		r.Spec.Image = defaults.DefaultImageName
+	}
+	if r.Spec.S3.Region == "" {
+		r.Spec.S3.Region = "us-east-1"
+	}
+	if r.Spec.ClusterID == "" {
+		r.Spec.ClusterID = "rZdE0DjZSrqy96PXrMUZVw"
+	}
+	if r.Spec.S3.Bucket == "" {
+		r.Spec.S3.Bucket = "ko3"
+	}
+	if r.Spec.Mode == "" {
+		r.Spec.Mode = AutoMQModeSeparated
+	}
+	if r.Spec.Exposure.Type == "" {
+		r.Spec.Exposure.Type = ExposureTypeNodePort
+	}
+	if tr := r.Spec.Exposure.TLSRoute; tr != nil && tr.Port == 0 {
+		tr.Port = defaultTLSRoutePort
+	}
+	if r.Spec.Controller.JVMOptions == nil {
+		r.Spec.Controller.JVMOptions = []string{"-Xms1g", "-Xmx1g", "-XX:MetaspaceSize=96m"}
+	}
+	if r.Spec.Controller.Replicas == 0 {
+		r.Spec.Controller.Replicas = 1
+	}
+	if r.Spec.Broker.JVMOptions == nil {
+		r.Spec.Broker.JVMOptions = []string{"-Xms1g", "-Xmx1g", "-XX:MetaspaceSize=96m", "-XX:MaxDirectMemorySize=1G"}
+	}
+	if r.Spec.Broker.Replicas == 0 {
+		r.Spec.Broker.Replicas = 1
+	}
+	if as := r.Spec.Broker.Autoscaling; as != nil && as.MinReplicas == 0 {
+		as.MinReplicas = 1
+	}
+	if r.Spec.RackAwareness != nil && r.Spec.RackAwareness.TopologyKey == "" {
+		r.Spec.RackAwareness.TopologyKey = corev1.LabelTopologyZone
+	}
+	if r.Spec.Security.Restricted {
+		if r.Spec.Sysctl.Enable == nil {
+			r.Spec.Sysctl.Enable = ptr.To(false)
+		}
+		if r.Spec.Security.RunAsUser == nil {
+			r.Spec.Security.RunAsUser = ptr.To(defaultRunAsID)
+		}
+		if r.Spec.Security.RunAsGroup == nil {
+			r.Spec.Security.RunAsGroup = ptr.To(defaultRunAsID)
+		}
+		if r.Spec.Security.FSGroup == nil {
+			r.Spec.Security.FSGroup = ptr.To(defaultRunAsID)
+		}
+	}
+	return nil
+}
+
+// defaultRunAsID is the default user and group ID of the AutoMQ containers in restricted mode.
+const defaultRunAsID int64 = 1000
+
+// defaultTLSRoutePort is the default port of the Gateway listener advertised with the TLSRoutes.
+const defaultTLSRoutePort int32 = 443
+
+//+kubebuilder:webhook:path=/validate-infra-cuisongliu-github-com-v1-automq,mutating=false,failurePolicy=fail,sideEffects=None,groups=infra.cuisongliu.github.com,resources=automqs,verbs=create;update;delete,versions=v1,name=vautomq.kb.io,admissionReviewVersions=v1
+
+//+kubebuilder:rbac:groups="",resources=services,verbs=list
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get
+//+kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get
+
+var _ admission.CustomValidator = &automqWebhook{}
+
+// ValidateCreate implements admission.CustomValidator so a webhook will be registered for the type
+func (w *automqWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	r, ok := obj.(*AutoMQ)
+	if !ok {
+		return nil, fmt.Errorf("expected an AutoMQ but got a %T", obj)
+	}
+	automqlog.Info("validate create", "name", r.Name)
+	allErrs, warnings := validate(r)
+	allErrs = append(allErrs, w.validateCluster(ctx, r, nil)...)
+	return warnings, r.invalid(allErrs)
+}
+
+// ValidateUpdate implements admission.CustomValidator so a webhook will be registered for the type
+func (w *automqWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	r, ok := newObj.(*AutoMQ)
+	if !ok {
+		return nil, fmt.Errorf("expected an AutoMQ but got a %T", newObj)
+	}
+	automqlog.Info("validate update", "name", r.Name)
+	mqOld := oldObj.(*AutoMQ)
+
+	specPath := field.NewPath("spec")
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(r.Spec.S3.Endpoint, mqOld.Spec.S3.Endpoint, specPath.Child("s3", "endpoint"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(r.Spec.S3.Region, mqOld.Spec.S3.Region, specPath.Child("s3", "region"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(r.Spec.S3.Bucket, mqOld.Spec.S3.Bucket, specPath.Child("s3", "bucket"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(r.Spec.ClusterID, mqOld.Spec.ClusterID, specPath.Child("clusterID"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(r.Spec.Mode, mqOld.Spec.Mode, specPath.Child("mode"))...)
+	if r.IsCombined() && r.Spec.Broker.Replicas != mqOld.Spec.Broker.Replicas {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("broker", "replicas"), r.Spec.Broker.Replicas, "field is immutable in combined mode"))
+	}
+	errs, warnings := validate(r)
+	allErrs = append(allErrs, errs...)
+	allErrs = append(allErrs, w.validateCluster(ctx, r, mqOld)...)
+	return warnings, r.invalid(allErrs)
+}
+
+// ValidateDelete implements admission.CustomValidator so a webhook will be registered for the type
+func (w *automqWebhook) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	r, ok := obj.(*AutoMQ)
+	if !ok {
+		return nil, fmt.Errorf("expected an AutoMQ but got a %T", obj)
+	}
+	automqlog.Info("validate delete", "name", r.Name)
+	if err := validateDelete(r); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// invalid returns the Invalid status error with all the field errors, or nil if there is no error.
+func (r *AutoMQ) invalid(allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("AutoMQ").GroupKind(), r.Name, allErrs)
+}
+
+// validateCluster checks the AutoMQ against the other resources of the cluster. On update the old AutoMQ is set,
+// and only the changed fields are checked, so the AutoMQ is not blocked by a resource changed after it is created.
+func (w *automqWebhook) validateCluster(ctx context.Context, r *AutoMQ, old *AutoMQ) field.ErrorList {
+	specPath := field.NewPath("spec")
+	var allErrs field.ErrorList
+	if r.Spec.NodePort != 0 && (old == nil || old.Spec.NodePort != r.Spec.NodePort) {
+		allErrs = append(allErrs, w.validateNodePort(ctx, r, specPath.Child("nodePort"))...)
+	}
+	if old == nil {
+		automqs := &AutoMQList{}
+		if err := w.Client.List(ctx, automqs, client.InNamespace(r.Namespace)); err != nil {
+			allErrs = append(allErrs, field.InternalError(specPath.Child("s3", "bucket"), err))
+		}
+		for _, item := range automqs.Items {
+			if item.Name != r.Name && item.Spec.S3.Bucket == r.Spec.S3.Bucket && item.Spec.ClusterID == r.Spec.ClusterID {
+				allErrs = append(allErrs, field.Invalid(specPath.Child("s3", "bucket"), r.Spec.S3.Bucket,
+					fmt.Sprintf("the bucket and the cluster ID %s are already used by the automq %s", r.Spec.ClusterID, item.Name)))
+			}
+		}
+	}
+	if old == nil || (r.Spec.DeletionPolicy == DeletionPolicyDeleteAll && old.Spec.DeletionPolicy != DeletionPolicyDeleteAll) {
+		allErrs = append(allErrs, w.validateSharedBucket(ctx, r, specPath)...)
+	}
+
+	storageClasses := map[string]*field.Path{r.Spec.Broker.StorageClass: specPath.Child("broker", "storageClass")}
+	if !r.IsCombined() {
+		if _, ok := storageClasses[r.Spec.Controller.StorageClass]; !ok {
+			storageClasses[r.Spec.Controller.StorageClass] = specPath.Child("controller", "storageClass")
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(storageClasses)) {
+		path := storageClasses[name]
+		if name == "" || (old != nil && (name == old.Spec.Controller.StorageClass || name == old.Spec.Broker.StorageClass)) {
+			continue
+		}
+		if err := w.Client.Get(ctx, client.ObjectKey{Name: name}, &storagev1.StorageClass{}); apierrors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(path, name))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(path, err))
+		}
+	}
+
+	var oldSecrets map[string]*field.Path
+	if old != nil {
+		oldSecrets = secretRefs(old)
+	}
+	secrets := secretRefs(r)
+	for _, name := range slices.Sorted(maps.Keys(secrets)) {
+		path := secrets[name]
+		if _, ok := oldSecrets[name]; ok {
+			continue
+		}
+		if err := w.Client.Get(ctx, client.ObjectKey{Namespace: r.Namespace, Name: name}, &corev1.Secret{}); apierrors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(path, name))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(path, err))
+		}
+	}
+	return allErrs
+}
+
+// validateSharedBucket rejects the DeleteAll deletion policy when the bucket is shared with another AutoMQ, the bucket
+// is deleted with the AutoMQ once the objects of the other clusters are gone.
+func (w *automqWebhook) validateSharedBucket(ctx context.Context, r *AutoMQ, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	automqs := &AutoMQList{}
+	if err := w.Client.List(ctx, automqs); err != nil {
+		return append(allErrs, field.InternalError(specPath.Child("s3", "bucket"), err))
+	}
+	for _, item := range automqs.Items {
+		if (item.Namespace == r.Namespace && item.Name == r.Name) || item.Spec.S3.Bucket != r.Spec.S3.Bucket || item.Spec.S3.Endpoint != r.Spec.S3.Endpoint {
+			continue
+		}
+		switch {
+		case r.Spec.DeletionPolicy == DeletionPolicyDeleteAll:
+			allErrs = append(allErrs, field.Invalid(specPath.Child("deletionPolicy"), r.Spec.DeletionPolicy,
+				fmt.Sprintf("the bucket %s is shared with the automq %s/%s", r.Spec.S3.Bucket, item.Namespace, item.Name)))
+		case item.Spec.DeletionPolicy == DeletionPolicyDeleteAll:
+			allErrs = append(allErrs, field.Invalid(specPath.Child("s3", "bucket"), r.Spec.S3.Bucket,
+				fmt.Sprintf("the bucket is deleted with the automq %s/%s of the deletionPolicy %s", item.Namespace, item.Name, DeletionPolicyDeleteAll)))
+		}
+	}
+	return allErrs
+}
+
+// validateNodePort rejects the node port used by a service not owned by the AutoMQ.
+func (w *automqWebhook) validateNodePort(ctx context.Context, r *AutoMQ, path *field.Path) field.ErrorList {
+	services := &corev1.ServiceList{}
+	if err := w.Client.List(ctx, services); err != nil {
+		return field.ErrorList{field.InternalError(path, err)}
+	}
+	for _, svc := range services.Items {
+		if svc.Namespace == r.Namespace && svc.Labels["app.kubernetes.io/owner-by"] == "automq" && svc.Labels["app.kubernetes.io/instance"] == r.Name {
+			continue
+		}
+		for _, port := range svc.Spec.Ports {
+			if port.NodePort == r.Spec.NodePort {
+				return field.ErrorList{field.Invalid(path, r.Spec.NodePort, fmt.Sprintf("the node port is already used by the service %s/%s", svc.Namespace, svc.Name))}
+			}
+		}
+	}
+	return nil
+}
+
+// secretRefs returns the secrets referenced by the S3 credentials, the TLSRoute certificate, the envs and the pod template volumes with the path of the first reference,
+// the optional references are skipped.
+func secretRefs(r *AutoMQ) map[string]*field.Path {
+	refs := map[string]*field.Path{}
+	add := func(name string, optional *bool, path *field.Path) {
+		if _, ok := refs[name]; !ok && name != "" && !ptr.Deref(optional, false) {
+			refs[name] = path
+		}
+	}
+	type role struct {
+		path        *field.Path
+		envs        []corev1.EnvVar
+		podTemplate *PodTemplate
+	}
+	add(r.Spec.S3.Credentials.SecretName, nil, field.NewPath("spec", "s3", "credentials", "secretName"))
+	if r.IsTLSRoute() && r.Spec.Exposure.TLSRoute != nil {
+		add(r.Spec.Exposure.TLSRoute.CertificateSecretName, nil, field.NewPath("spec", "exposure", "tlsRoute", "certificateSecretName"))
+	}
+	roles := []role{{field.NewPath("spec", "broker"), r.Spec.Broker.Env, r.Spec.Broker.PodTemplate}}
+	if !r.IsCombined() {
+		roles = append(roles, role{field.NewPath("spec", "controller"), r.Spec.Controller.Env, r.Spec.Controller.PodTemplate})
+	}
+	for _, role := range roles {
+		for i, env := range role.envs {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				ref := env.ValueFrom.SecretKeyRef
+				add(ref.Name, ref.Optional, role.path.Child("env").Index(i).Child("valueFrom", "secretKeyRef", "name"))
+			}
+		}
+		if role.podTemplate == nil {
+			continue
+		}
+		for i, volume := range role.podTemplate.Volumes {
+			if volume.Secret != nil {
+				add(volume.Secret.SecretName, volume.Secret.Optional, role.path.Child("podTemplate", "volumes").Index(i).Child("secret", "secretName"))
+			}
+		}
+	}
+	return refs
+}
+
+// validateS3Credentials requires the secret name or both the inline keys, the inline keys are deprecated.
+func validateS3Credentials(path *field.Path, credentials S3Credentials) (field.ErrorList, admission.Warnings) {
+	if credentials.SecretName != "" {
+		if credentials.AccessKeyID != "" || credentials.SecretAccessKey != "" {
+			return field.ErrorList{field.Forbidden(path, "secretName and the inline access key are mutually exclusive")}, nil
+		}
+		return nil, nil
+	}
+	var allErrs field.ErrorList
+	if credentials.AccessKeyID == "" {
+		allErrs = append(allErrs, field.Required(path.Child("accessKeyID"), "either secretName or the inline access key is required"))
+	}
+	if credentials.SecretAccessKey == "" {
+		allErrs = append(allErrs, field.Required(path.Child("secretAccessKey"), "either secretName or the inline access key is required"))
+	}
+	if len(allErrs) != 0 {
+		return allErrs, nil
+	}
+	return nil, admission.Warnings{fmt.Sprintf("%s is deprecated, use %s instead", path.Child("accessKeyID"), path.Child("secretName"))}
+}
+
+// validateDelete rejects the deletion of the protected AutoMQ or the AutoMQ with the ready brokers
+// unless the force annotation is set.
+func validateDelete(r *AutoMQ) error {
+	annotations := r.GetAnnotations()
+	if annotations[ForceDeleteAnnotation] == "true" {
+		return nil
+	}
+	if annotations[DeletionProtectionAnnotation] == "true" {
+		return fmt.Errorf("the automq is protected by the annotation %s, set the annotation %s to \"true\" to delete it",
+			DeletionProtectionAnnotation, ForceDeleteAnnotation)
+	}
+	if ready := r.Status.ReadyBrokerReplicas; ready > 0 {
+		return fmt.Errorf("the automq has %d ready brokers serving traffic, set the annotation %s to \"true\" to delete it",
+			ready, ForceDeleteAnnotation)
+	}
+	return nil
+}
+
+// validate returns all the errors of the spec, and the warnings of the risky but allowed settings.
+func validate(r *AutoMQ) (field.ErrorList, admission.Warnings) {
+	specPath := field.NewPath("spec")
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+	if r.Spec.S3.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("s3", "endpoint"), ""))
+	}
+	if r.Spec.S3.Region == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("s3", "region"), ""))
+	}
+	if r.Spec.S3.Bucket == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("s3", "bucket"), ""))
+	}
+	errs, ws := validateS3Credentials(specPath.Child("s3", "credentials"), r.Spec.S3.Credentials)
+	allErrs = append(allErrs, errs...)
+	warnings = append(warnings, ws...)
+	if r.Spec.ClusterID == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("clusterID"), ""))
+	}
+	if r.Spec.Image == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("image"), ""))
+	}
+	if r.Spec.NodePort < 0 || r.Spec.NodePort > 65535 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("nodePort"), r.Spec.NodePort, "must be between 1 and 65535, or 0 to allocate it automatically"))
+	} else if r.Spec.NodePort != 0 && (r.Spec.NodePort < defaultNodePortMin || r.Spec.NodePort > defaultNodePortMax) {
+		warnings = append(warnings, fmt.Sprintf("spec.nodePort %d is out of the default node port range %d-%d of the cluster",
+			r.Spec.NodePort, defaultNodePortMin, defaultNodePortMax))
+	}
+	seenAddressTypes := map[corev1.NodeAddressType]bool{}
+	for i, addressType := range r.Spec.NodeAddressTypes {
+		path := specPath.Child("nodeAddressTypes").Index(i)
+		if !slices.Contains(supportedNodeAddressTypes, string(addressType)) {
+			allErrs = append(allErrs, field.NotSupported(path, addressType, supportedNodeAddressTypes))
+		} else if seenAddressTypes[addressType] {
+			allErrs = append(allErrs, field.Duplicate(path, addressType))
+		}
+		seenAddressTypes[addressType] = true
+	}
+	errs, ws = validateExposure(specPath.Child("exposure"), r.Spec.Exposure)
+	allErrs = append(allErrs, errs...)
+	warnings = append(warnings, ws...)
+	if r.Spec.DeletionPolicy == DeletionPolicyDeleteAll {
+		warnings = append(warnings, fmt.Sprintf("spec.deletionPolicy %s deletes the objects of the cluster ID %s from the bucket %s when the automq is deleted",
+			DeletionPolicyDeleteAll, r.Spec.ClusterID, r.Spec.S3.Bucket))
+	}
+
+	if !r.IsCombined() {
+		controllerPath := specPath.Child("controller")
+		if r.Spec.Controller.Replicas%2 == 0 {
+			warnings = append(warnings, fmt.Sprintf("spec.controller.replicas %d is even, it tolerates no more failures than %d replicas",
+				r.Spec.Controller.Replicas, r.Spec.Controller.Replicas-1))
+		}
+		errs, ws := validateJVMOptions(controllerPath, r.Spec.Controller.JVMOptions, r.Spec.Controller.Resources)
+		allErrs = append(allErrs, errs...)
+		warnings = append(warnings, ws...)
+		allErrs = append(allErrs, validateEnvs(controllerPath.Child("env"), r.Spec.Controller.Env)...)
+		allErrs = append(allErrs, validateAffinity(controllerPath.Child("affinity"), r.Spec.Controller.Affinity)...)
+		allErrs = append(allErrs, validatePodTemplate(controllerPath.Child("podTemplate"), r.Spec.Controller.PodTemplate)...)
+	}
+	brokerPath := specPath.Child("broker")
+	errs, ws = validateJVMOptions(brokerPath, r.Spec.Broker.JVMOptions, r.Spec.Broker.Resources)
+	allErrs = append(allErrs, errs...)
+	warnings = append(warnings, ws...)
+	allErrs = append(allErrs, validateEnvs(brokerPath.Child("env"), r.Spec.Broker.Env)...)
+	allErrs = append(allErrs, validateAffinity(brokerPath.Child("affinity"), r.Spec.Broker.Affinity)...)
+	allErrs = append(allErrs, validatePodTemplate(brokerPath.Child("podTemplate"), r.Spec.Broker.PodTemplate)...)
+	allErrs = append(allErrs, validateAutoscaling(r)...)
+	if r.Spec.Broker.HostNetwork {
+		// the brokers on the host network advertise the node address, and the host network is not allowed by the restricted pod security
+		if r.IsLoadBalancer() || r.IsTLSRoute() {
+			allErrs = append(allErrs, field.Invalid(brokerPath.Child("hostNetwork"), true, fmt.Sprintf("must be false with the exposure type %s", r.Spec.Exposure.Type)))
+		}
+		if r.Spec.Security.Restricted {
+			allErrs = append(allErrs, field.Invalid(brokerPath.Child("hostNetwork"), true, "must be false when spec.security.restricted is true"))
+		}
+	}
+
+	allErrs = append(allErrs, validateSysctl(specPath.Child("sysctl"), r.Spec.Sysctl)...)
+	if r.Spec.Security.Restricted && r.Spec.Sysctl.IsEnabled() {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("sysctl", "enable"), true, "must be false when spec.security.restricted is true"))
+	}
+	allErrs = append(allErrs, validateAutoBalancer(specPath.Child("autoBalancer"), r.Spec.AutoBalancer)...)
+	return allErrs, warnings
+}
+
+// defaultNodePortMin and defaultNodePortMax are the default --service-node-port-range of the kube-apiserver.
+const (
+	defaultNodePortMin int32 = 30000
+	defaultNodePortMax int32 = 32767
+)
+
+// validateJVMOptions checks the heap and the direct memory of the JVM fit in the memory limit of the container,
+// the memory request is used if the limit is not set.
+func validateJVMOptions(path *field.Path, jvmOptions []string, resources corev1.ResourceRequirements) (field.ErrorList, admission.Warnings) {
+	optionsPath := path.Child("jvmOptions")
+	if len(jvmOptions) == 0 {
+		return field.ErrorList{field.Required(optionsPath, "")}, nil
+	}
+	var allErrs field.ErrorList
+	var heap, direct int64
+	for i, option := range jvmOptions {
+		var size string
+		var target *int64
+		if s, ok := strings.CutPrefix(option, "-Xmx"); ok {
+			size, target = s, &heap
+		} else if s, ok := strings.CutPrefix(option, "-XX:MaxDirectMemorySize="); ok {
+			size, target = s, &direct
+		} else {
+			continue
+		}
+		bytes, err := parseJVMSize(size)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(optionsPath.Index(i), option, err.Error()))
+			continue
+		}
+		*target = bytes
+	}
+	memory := resources.Limits.Memory()
+	memoryPath := path.Child("resources", "limits", "memory")
+	if memory.IsZero() {
+		memory = resources.Requests.Memory()
+		memoryPath = path.Child("resources", "requests", "memory")
+	}
+	if len(allErrs) > 0 || memory.IsZero() || heap == 0 {
+		return allErrs, nil
+	}
+	total := heap + direct
+	if total > memory.Value() {
+		return append(allErrs, field.Invalid(memoryPath, memory.String(),
+			fmt.Sprintf("must not be less than the heap and the direct memory %s in %s", resource.NewQuantity(total, resource.BinarySI), optionsPath))), nil
+	}
+	if total*10 > memory.Value()*9 {
+		return allErrs, admission.Warnings{fmt.Sprintf("%s %s leaves less than 10%% for the metaspace and the native memory of the JVM options", memoryPath, memory.String())}
+	}
+	return allErrs, nil
+}
+
+// parseJVMSize parses the size of the JVM options, e.g. 1g, 512m or 1024k.
+func parseJVMSize(size string) (int64, error) {
+	if size == "" {
+		return 0, fmt.Errorf("size is empty")
+	}
+	unit := int64(1)
+	switch size[len(size)-1] {
+	case 'k', 'K':
+		unit = 1 << 10
+	case 'm', 'M':
+		unit = 1 << 20
+	case 'g', 'G':
+		unit = 1 << 30
+	case 't', 'T':
+		unit = 1 << 40
+	}
+	if unit != 1 {
+		size = size[:len(size)-1]
+	}
+	n, err := strconv.ParseInt(size, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("size must be a positive number with an optional unit of k, m, g or t")
+	}
+	return n * unit, nil
+}
+
+// supportedNodeAddressTypes are the node address types advertised to the clients
+var supportedNodeAddressTypes = []string{string(corev1.NodeExternalIP), string(corev1.NodeInternalIP), string(corev1.NodeHostName)}
+
+// reservedEnvNames are the environment variables managed by the operator, they can not be overridden by the envs.
+var reservedEnvNames = []string{
+	"NAMESPACE_NAME", "POD_NAME", "POD_IP", "NODE_NAME",
+	"KAFKA_S3_ACCESS_KEY", "KAFKA_S3_SECRET_KEY", "KAFKA_HEAP_OPTS",
+	"NODEPORT_DEFAULT_PORT", "OPERATOR_APIS_ADDR", "OPERATOR_APIS_TOKEN", "OPERATOR_APIS_CA", "AUTOMQ_NAME", "AUTOMQ_RUN_INFO_FILE",
+	"KAFKA_EXTERNAL_LISTENER_PORT", "KAFKA_EXTERNAL_TLS_DIR",
+	"AUTO_BALANCER_ENABLE", "RACK_TOPOLOGY_KEY", "KAFKA_CFG_S3_TELEMETRY_METRICS_EXPORTER_URI", "KAFKA_CFG_REPLICA_SELECTOR_CLASS",
+}
+
+func validateEnvs(path *field.Path, envs []corev1.EnvVar) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, env := range envs {
+		if env.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Index(i).Child("name"), ""))
+			continue
+		}
+		if slices.Contains(reservedEnvNames, env.Name) {
+			allErrs = append(allErrs, field.Forbidden(path.Index(i).Child("name"), fmt.Sprintf("%s is managed by the operator", env.Name)))
+		} else if strings.HasPrefix(env.Name, "KAFKA_CFG_AUTOBALANCER_") {
+			allErrs = append(allErrs, field.Forbidden(path.Index(i).Child("name"), fmt.Sprintf("%s is managed by the operator, use spec.autoBalancer instead", env.Name)))
+		}
+	}
+	return allErrs
+}
+
+func validateAffinity(path *field.Path, affinity *AffinitySpec) field.ErrorList {
+	if affinity == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	validateType := func(path *field.Path, typ string, weight int32) {
+		if typ == "" {
+			allErrs = append(allErrs, field.Required(path.Child("type"), ""))
+		}
+		if typ == "soft" && (weight < 1 || weight > 100) {
+			allErrs = append(allErrs, field.Invalid(path.Child("weight"), weight, "must be between 1 and 100 when the type is soft"))
+		}
+	}
+	if a := affinity.PodAntiAffinity; a != nil {
+		validateType(path.Child("podAntiAffinity"), a.Type, a.Weight)
+	}
+	if a := affinity.PodAffinity; a != nil {
+		validateType(path.Child("podAffinity"), a.Type, a.Weight)
+	}
+	if a := affinity.NodeAffinity; a != nil {
+		nodeAffinityPath := path.Child("nodeAffinity")
+		validateType(nodeAffinityPath, a.Type, a.Weight)
+		for i, selector := range a.NodeSelector {
+			if selector.Key == "" {
+				allErrs = append(allErrs, field.Required(nodeAffinityPath.Child("nodeSelector").Index(i).Child("key"), ""))
+			}
+			if len(selector.Values) == 0 {
+				allErrs = append(allErrs, field.Required(nodeAffinityPath.Child("nodeSelector").Index(i).Child("values"), ""))
+			}
+		}
+	}
+	return allErrs
+}
+
+// reservedContainerNames and reservedVolumeNames are managed by the operator and can not be used in the pod template.
+var (
+	reservedContainerNames = []string{"controller", "broker", "sysctl", "config"}
+	reservedVolumeNames    = []string{"script", "k8tz", "kafka-config", "kafka-logs", "tmp"}
+)
+
+func validatePodTemplate(path *field.Path, tpl *PodTemplate) field.ErrorList {
+	if tpl == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	validateContainers := func(path *field.Path, containers []corev1.Container) {
+		for i, c := range containers {
+			if c.Name == "" {
+				allErrs = append(allErrs, field.Required(path.Index(i).Child("name"), ""))
+			} else if slices.Contains(reservedContainerNames, c.Name) {
+				allErrs = append(allErrs, field.Forbidden(path.Index(i).Child("name"), fmt.Sprintf("container name %s is reserved", c.Name)))
+			}
+		}
+	}
+	validateContainers(path.Child("sidecars"), tpl.Sidecars)
+	validateContainers(path.Child("initContainers"), tpl.InitContainers)
+	for i, v := range tpl.Volumes {
+		if slices.Contains(reservedVolumeNames, v.Name) || strings.HasPrefix(v.Name, "automq-") {
+			allErrs = append(allErrs, field.Forbidden(path.Child("volumes").Index(i).Child("name"), fmt.Sprintf("volume name %s is reserved", v.Name)))
+		}
+	}
+	for i, m := range tpl.VolumeMounts {
+		if m.MountPath == "/data/kafka" || strings.HasPrefix(m.MountPath, "/opt/kafka") {
+			allErrs = append(allErrs, field.Forbidden(path.Child("volumeMounts").Index(i).Child("mountPath"), fmt.Sprintf("mount path %s is reserved", m.MountPath)))
+		}
+	}
+	return allErrs
+}
+
+// safeSysctls is the namespaced sysctls that kubelet allows by default and the pod security standards accept.
+var safeSysctls = []string{
+	"kernel.shm_rmid_forced",
+	"net.ipv4.ip_local_port_range",
+	"net.ipv4.ip_unprivileged_port_start",
+	"net.ipv4.tcp_syncookies",
+	"net.ipv4.ping_group_range",
+	"net.ipv4.ip_local_reserved_ports",
+	"net.ipv4.tcp_keepalive_time",
+	"net.ipv4.tcp_fin_timeout",
+	"net.ipv4.tcp_keepalive_intvl",
+	"net.ipv4.tcp_keepalive_probes",
+}
+
+func validateSysctl(path *field.Path, sysctl SysctlSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, kv := range sysctl.Sysctls {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok || strings.TrimSpace(key) == "" || strings.TrimSpace(value) == "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("sysctls").Index(i), kv, "must be in the format of key=value"))
+		}
+	}
+	for i, s := range sysctl.PodSysctls {
+		if !slices.Contains(safeSysctls, s.Name) {
+			allErrs = append(allErrs, field.Forbidden(path.Child("podSysctls").Index(i).Child("name"),
+				fmt.Sprintf("%s is unsafe, set it by spec.sysctl.sysctls with the init container instead", s.Name)))
+		}
+	}
+	return allErrs
+}
+
+func validateAutoscaling(r *AutoMQ) field.ErrorList {
+	as := r.Spec.Broker.Autoscaling
+	if as == nil || !as.Enable {
+		return nil
+	}
+	path := field.NewPath("spec", "broker", "autoscaling")
+	if r.IsCombined() {
+		return field.ErrorList{field.Forbidden(path, "autoscaling is not supported in combined mode")}
+	}
+	var allErrs field.ErrorList
+	if as.MinReplicas > as.MaxReplicas {
+		allErrs = append(allErrs, field.Invalid(path.Child("minReplicas"), as.MinReplicas, "must not be greater than maxReplicas"))
+	}
+	if as.TargetNetworkInBytesPerSecond == nil && as.TargetNetworkOutBytesPerSecond == nil && as.TargetCPUUtilization == nil {
+		allErrs = append(allErrs, field.Required(path, "at least one target is required"))
+	}
+	if as.TargetCPUUtilization != nil && r.Spec.Broker.Resources.Requests.Cpu().IsZero() {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "broker", "resources", "requests", "cpu"),
+			"required by spec.broker.autoscaling.targetCPUUtilization"))
+	}
+	return allErrs
+}
+
+// supportedExposureTypes are the types of the exposure of the brokers
+var supportedExposureTypes = []string{string(ExposureTypeNodePort), string(ExposureTypeLoadBalancer), string(ExposureTypeTLSRoute)}
+
+func validateExposure(path *field.Path, exposure ExposureSpec) (field.ErrorList, admission.Warnings) {
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+	if exposure.Type != "" && !slices.Contains(supportedExposureTypes, string(exposure.Type)) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("type"), exposure.Type, supportedExposureTypes))
+	}
+	if lb := exposure.LoadBalancer; lb != nil {
+		lbPath := path.Child("loadBalancer")
+		if exposure.Type != ExposureTypeLoadBalancer {
+			warnings = append(warnings, fmt.Sprintf("spec.exposure.loadBalancer is ignored by the exposure type %s", exposure.Type))
+		}
+		allErrs = append(allErrs, apivalidation.ValidateAnnotations(lb.Annotations, lbPath.Child("annotations"))...)
+		for i, cidr := range lb.LoadBalancerSourceRanges {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				allErrs = append(allErrs, field.Invalid(lbPath.Child("loadBalancerSourceRanges").Index(i), cidr, "must be a CIDR, e.g. 10.0.0.0/8"))
+			}
+		}
+		if lb.HostnameTemplate != "" {
+			allErrs = append(allErrs, validateHostnameTemplate(lbPath.Child("hostnameTemplate"), lb.HostnameTemplate)...)
+		}
+		if lb.BootstrapHostname != "" {
+			allErrs = append(allErrs, validateHostname(lbPath.Child("bootstrapHostname"), lb.BootstrapHostname)...)
+		}
+	}
+	tr := exposure.TLSRoute
+	trPath := path.Child("tlsRoute")
+	if tr == nil {
+		if exposure.Type == ExposureTypeTLSRoute {
+			allErrs = append(allErrs, field.Required(trPath, "required by the exposure type TLSRoute"))
+		}
+		return allErrs, warnings
+	}
+	if exposure.Type != ExposureTypeTLSRoute {
+		warnings = append(warnings, fmt.Sprintf("spec.exposure.tlsRoute is ignored by the exposure type %s", exposure.Type))
+	}
+	if tr.GatewayName == "" {
+		allErrs = append(allErrs, field.Required(trPath.Child("gatewayName"), ""))
+	}
+	if tr.Port < 0 || tr.Port > 65535 {
+		allErrs = append(allErrs, field.Invalid(trPath.Child("port"), tr.Port, "must be between 1 and 65535"))
+	}
+	if tr.HostnameTemplate == "" {
+		allErrs = append(allErrs, field.Required(trPath.Child("hostnameTemplate"), ""))
+	} else {
+		allErrs = append(allErrs, validateHostnameTemplate(trPath.Child("hostnameTemplate"), tr.HostnameTemplate)...)
+	}
+	if tr.BootstrapHostname == "" {
+		allErrs = append(allErrs, field.Required(trPath.Child("bootstrapHostname"), ""))
+	} else {
+		allErrs = append(allErrs, validateHostname(trPath.Child("bootstrapHostname"), tr.BootstrapHostname)...)
+	}
+	if tr.CertificateSecretName == "" {
+		allErrs = append(allErrs, field.Required(trPath.Child("certificateSecretName"), ""))
+	}
+	return allErrs, warnings
+}
+
+// validateHostnameTemplate requires the hostname of the broker to be rendered apart by the index.
+func validateHostnameTemplate(path *field.Path, template string) field.ErrorList {
+	if !strings.Contains(template, "{index}") {
+		return field.ErrorList{field.Invalid(path, template, "must contain {index} to name the brokers apart")}
+	}
+	return validateHostname(path, template)
+}
+
+func validateHostname(path *field.Path, template string) field.ErrorList {
+	if msgs := validation.IsDNS1123Subdomain(RenderHostname(template, "automq", "default", 0)); len(msgs) != 0 {
+		return field.ErrorList{field.Invalid(path, template, strings.Join(msgs, ", "))}
+	}
+	return nil
+}
+
+func validateAutoBalancer(path *field.Path, ab AutoBalancerSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, goal := range ab.Goals {
+		if strings.TrimSpace(goal) == "" || strings.Contains(goal, ",") {
+			allErrs = append(allErrs, field.Invalid(path.Child("goals").Index(i), goal, "must be a non-empty goal without comma"))
+		}
+	}
+	for i, topic := range ab.ExcludeTopics {
+		if strings.TrimSpace(topic) == "" || strings.Contains(topic, ",") {
+			allErrs = append(allErrs, field.Invalid(path.Child("excludeTopics").Index(i), topic, "must be a non-empty topic without comma"))
+		}
+	}
+	if ab.NetworkInCapacity != nil && ab.NetworkInCapacity.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("networkInCapacity"), ab.NetworkInCapacity.String(), "must be greater than 0"))
+	}
+	if ab.NetworkOutCapacity != nil && ab.NetworkOutCapacity.Sign() <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("networkOutCapacity"), ab.NetworkOutCapacity.String(), "must be greater than 0"))
+	}
+	return allErrs
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2024 cuisongliu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDelete(t *testing.T) {
+	r := &AutoMQ{}
+	r.Status.ReadyBrokers = "ready 2 of 3"
+	r.Status.ReadyBrokerReplicas = 2
+	if err := validateDelete(r); err == nil || !strings.Contains(err.Error(), "2 ready brokers") {
+		t.Errorf("validateDelete() of the ready brokers = %v, want rejected", err)
+	}
+	r.Status.ReadyBrokerReplicas = 0
+	if err := validateDelete(r); err != nil {
+		t.Errorf("validateDelete() without the ready brokers = %v", err)
+	}
+	r.Status.ReadyBrokerReplicas = 2
+	r.Annotations = map[string]string{ForceDeleteAnnotation: "true"}
+	if err := validateDelete(r); err != nil {
+		t.Errorf("validateDelete() with the force annotation = %v", err)
+	}
+}
@@ -0,0 +1,528 @@
+/*
+Copyright 2024 cuisongliu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/cuisongliu/automq-operator/defaults"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	//+kubebuilder:scaffold:imports
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// These tests use Ginkgo (BDD-style Go testing framework). Refer to
+// http://onsi.github.io/ginkgo/ to learn more about Ginkgo.
+
+var cfg *rest.Config
+var k8sClient client.Client
+var testEnv *envtest.Environment
+var ctx context.Context
+var cancel context.CancelFunc
+
+func TestAPIs(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecs(t, "Webhook Suite")
+}
+
+func initAutoMQ() *AutoMQ {
+	return &AutoMQ{
+		ObjectMeta: ctrl.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: AutoMQSpec{
+			S3: S3Spec{
+				Endpoint: "http://localhost:9000",
+				Credentials: S3Credentials{
+					AccessKeyID:     "minioadmin",
+					SecretAccessKey: "minioadmin",
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("Default", func() {
+	Context("Default Webhook", func() {
+		BeforeEach(func() {
+			aq := initAutoMQ()
+			_ = k8sClient.Delete(context.Background(), aq)
+		})
+		It("Default ImageName", func() {
+			aq := initAutoMQ()
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			err = k8sClient.Get(context.Background(), client.ObjectKeyFromObject(aq), aq)
+			Expect(err).To(BeNil())
+			Expect(aq.Spec.Image).To(Equal(defaults.DefaultImageName))
+		})
+		It("Default Region", func() {
+			aq := initAutoMQ()
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			err = k8sClient.Get(context.Background(), client.ObjectKeyFromObject(aq), aq)
+			Expect(err).To(BeNil())
+			Expect(aq.Spec.S3.Region).To(Equal("us-east-1"))
+		})
+		It("Default ClusterID", func() {
+			aq := initAutoMQ()
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			err = k8sClient.Get(context.Background(), client.ObjectKeyFromObject(aq), aq)
+			Expect(err).To(BeNil())
+			Expect(aq.Spec.ClusterID).To(Equal("rZdE0DjZSrqy96PXrMUZVw"))
+			err = k8sClient.Delete(context.Background(), aq)
+			Expect(err).To(BeNil())
+		})
+		It("Default Bucket", func() {
+			aq := initAutoMQ()
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			err = k8sClient.Get(context.Background(), client.ObjectKeyFromObject(aq), aq)
+			Expect(err).To(BeNil())
+			Expect(aq.Spec.S3.Bucket).To(Equal("ko3"))
+		})
+		It("Default Replicas", func() {
+			aq := initAutoMQ()
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			err = k8sClient.Get(context.Background(), client.ObjectKeyFromObject(aq), aq)
+			Expect(err).To(BeNil())
+			Expect(aq.Spec.Controller.Replicas).To(Equal(int32(1)))
+			Expect(aq.Spec.Broker.Replicas).To(Equal(int32(1)))
+		})
+		It("Default JVM", func() {
+			aq := initAutoMQ()
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			err = k8sClient.Get(context.Background(), client.ObjectKeyFromObject(aq), aq)
+			Expect(err).To(BeNil())
+			Expect(aq.Spec.Controller.JVMOptions).To(Equal([]string{"-Xms1g", "-Xmx1g", "-XX:MetaspaceSize=96m"}))
+			Expect(aq.Spec.Broker.JVMOptions).To(Equal([]string{"-Xms1g", "-Xmx1g", "-XX:MetaspaceSize=96m", "-XX:MaxDirectMemorySize=1G"}))
+		})
+	})
+
+})
+var _ = Describe("Update", func() {
+	Context("Update Webhook", func() {
+		BeforeEach(func() {
+			aq := initAutoMQ()
+			_ = k8sClient.Delete(context.Background(), aq)
+		})
+		DescribeTable("Update Immutable Field",
+			func(mode AutoMQMode, update func(aq *AutoMQ), path string) {
+				aq := initAutoMQ()
+				aq.Spec.Mode = mode
+				err := k8sClient.Create(context.Background(), aq)
+				Expect(err).To(BeNil())
+				update(aq)
+				err = k8sClient.Update(context.Background(), aq)
+				Expect(true).To(Equal(errors.IsInvalid(err)))
+				Expect(err.Error()).To(ContainSubstring(path))
+				Expect(err.Error()).To(ContainSubstring("immutable"))
+			},
+			Entry("Update Endpoint", AutoMQModeSeparated, func(aq *AutoMQ) { aq.Spec.S3.Endpoint = "http://localhost:9001" }, "spec.s3.endpoint"),
+			Entry("Update Region", AutoMQModeSeparated, func(aq *AutoMQ) { aq.Spec.S3.Region = "minioadmin1" }, "spec.s3.region"),
+			Entry("Update Bucket", AutoMQModeSeparated, func(aq *AutoMQ) { aq.Spec.S3.Bucket = "minioadmin1" }, "spec.s3.bucket"),
+			Entry("Update ClusterID", AutoMQModeSeparated, func(aq *AutoMQ) { aq.Spec.ClusterID = "minioadmin1" }, "spec.clusterID"),
+			Entry("Update Mode", AutoMQModeSeparated, func(aq *AutoMQ) { aq.Spec.Mode = AutoMQModeCombined }, "spec.mode"),
+			Entry("Update Combined Broker Replicas", AutoMQModeCombined, func(aq *AutoMQ) { aq.Spec.Broker.Replicas = 3 }, "spec.broker.replicas"),
+		)
+		It("Update Controller Replicas", func() {
+			aq := initAutoMQ()
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			aq.Spec.Controller.Replicas = 3
+			err = k8sClient.Update(context.Background(), aq)
+			Expect(err).To(BeNil())
+		})
+		It("Update All Immutable Fields", func() {
+			aq := initAutoMQ()
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			aq.Spec.S3.Endpoint = "http://localhost:9001"
+			aq.Spec.ClusterID = "minioadmin1"
+			err = k8sClient.Update(context.Background(), aq)
+			Expect(true).To(Equal(errors.IsInvalid(err)))
+			Expect(err.Error()).To(ContainSubstring("spec.s3.endpoint"))
+			Expect(err.Error()).To(ContainSubstring("spec.clusterID"))
+		})
+	})
+
+})
+
+var _ = Describe("Validate", func() {
+	Context("Validate Webhook", func() {
+		BeforeEach(func() {
+			aq := initAutoMQ()
+			_ = k8sClient.Delete(context.Background(), aq)
+		})
+		DescribeTable("Invalid Spec",
+			func(mutate func(aq *AutoMQ), messages ...string) {
+				aq := initAutoMQ()
+				mutate(aq)
+				err := k8sClient.Create(context.Background(), aq)
+				Expect(true).To(Equal(errors.IsInvalid(err)))
+				for _, message := range messages {
+					Expect(err.Error()).To(ContainSubstring(message))
+				}
+			},
+			Entry("Unsafe Pod Sysctl", func(aq *AutoMQ) {
+				aq.Spec.Sysctl.PodSysctls = []corev1.Sysctl{{Name: "net.core.somaxconn", Value: "65535"}}
+			}, "spec.sysctl.podSysctls[0].name", "unsafe"),
+			Entry("Invalid Sysctl", func(aq *AutoMQ) {
+				aq.Spec.Sysctl.Sysctls = []string{"vm.max_map_count"}
+			}, "spec.sysctl.sysctls[0]", "key=value"),
+			Entry("Restricted Security With Sysctl", func(aq *AutoMQ) {
+				enabled := true
+				aq.Spec.Sysctl.Enable = &enabled
+				aq.Spec.Security.Restricted = true
+			}, "spec.sysctl.enable", "security.restricted"),
+			Entry("Invalid Auto Balancer Exclude Topics", func(aq *AutoMQ) {
+				aq.Spec.AutoBalancer.ExcludeTopics = []string{"a,b"}
+			}, "spec.autoBalancer.excludeTopics[0]"),
+			Entry("Invalid NodePort", func(aq *AutoMQ) {
+				aq.Spec.NodePort = 70000
+			}, "spec.nodePort"),
+			Entry("Broker Affinity Without Type", func(aq *AutoMQ) {
+				aq.Spec.Broker.Affinity = &AffinitySpec{PodAntiAffinity: &PodAntiAffinity{Weight: 40}}
+			}, "spec.broker.affinity.podAntiAffinity.type"),
+			Entry("Broker Node Affinity Without Key", func(aq *AutoMQ) {
+				aq.Spec.Broker.Affinity = &AffinitySpec{NodeAffinity: &NodeAffinity{Type: "hard", NodeSelector: []NodeSelector{{Values: []string{"a"}}}}}
+			}, "spec.broker.affinity.nodeAffinity.nodeSelector[0].key"),
+			Entry("Broker Heap Exceeds Memory Limit", func(aq *AutoMQ) {
+				aq.Spec.Broker.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}
+			}, "spec.broker.resources.limits.memory", "2Gi"),
+			Entry("Invalid JVM Size", func(aq *AutoMQ) {
+				aq.Spec.Controller.JVMOptions = []string{"-Xmx1x"}
+			}, "spec.controller.jvmOptions[0]"),
+			Entry("Override Operator Env", func(aq *AutoMQ) {
+				aq.Spec.Broker.Env = []corev1.EnvVar{{Name: "KAFKA_S3_ACCESS_KEY", Value: "admin"}}
+			}, "spec.broker.env[0].name", "KAFKA_S3_ACCESS_KEY"),
+			Entry("Reserved Sidecar Name", func(aq *AutoMQ) {
+				aq.Spec.Broker.PodTemplate = &PodTemplate{Sidecars: []corev1.Container{{Name: "broker"}}}
+			}, "spec.broker.podTemplate.sidecars[0].name", "reserved"),
+			Entry("Duplicate Node Address Type", func(aq *AutoMQ) {
+				aq.Spec.NodeAddressTypes = []corev1.NodeAddressType{corev1.NodeExternalIP, corev1.NodeExternalIP}
+			}, "spec.nodeAddressTypes[1]"),
+			Entry("Unsupported Node Address Type", func(aq *AutoMQ) {
+				aq.Spec.NodeAddressTypes = []corev1.NodeAddressType{corev1.NodeInternalDNS}
+			}, "spec.nodeAddressTypes[0]", "InternalDNS"),
+			Entry("Load Balancer Hostname Template Without Index", func(aq *AutoMQ) {
+				aq.Spec.Exposure = ExposureSpec{Type: ExposureTypeLoadBalancer, LoadBalancer: &LoadBalancerSpec{
+					HostnameTemplate: "{name}.kafka.example.com",
+				}}
+			}, "spec.exposure.loadBalancer.hostnameTemplate", "{index}"),
+			Entry("Invalid Load Balancer Source Range", func(aq *AutoMQ) {
+				aq.Spec.Exposure = ExposureSpec{Type: ExposureTypeLoadBalancer, LoadBalancer: &LoadBalancerSpec{
+					LoadBalancerSourceRanges: []string{"10.0.0.0"},
+					BootstrapHostname:        "Kafka_{name}.example.com",
+				}}
+			}, "spec.exposure.loadBalancer.loadBalancerSourceRanges[0]", "spec.exposure.loadBalancer.bootstrapHostname"),
+			Entry("Missing TLSRoute", func(aq *AutoMQ) {
+				aq.Spec.Exposure = ExposureSpec{Type: ExposureTypeTLSRoute}
+			}, "spec.exposure.tlsRoute", "required by the exposure type TLSRoute"),
+			Entry("Incomplete TLSRoute", func(aq *AutoMQ) {
+				aq.Spec.Exposure = ExposureSpec{Type: ExposureTypeTLSRoute, TLSRoute: &TLSRouteSpec{
+					HostnameTemplate: "{name}.kafka.example.com",
+				}}
+			}, "spec.exposure.tlsRoute.gatewayName", "spec.exposure.tlsRoute.hostnameTemplate", "spec.exposure.tlsRoute.bootstrapHostname",
+				"spec.exposure.tlsRoute.certificateSecretName"),
+			Entry("Host Network With Load Balancer", func(aq *AutoMQ) {
+				aq.Spec.Broker.HostNetwork = true
+				aq.Spec.Exposure = ExposureSpec{Type: ExposureTypeLoadBalancer}
+			}, "spec.broker.hostNetwork", "LoadBalancer"),
+			Entry("Restricted Host Network", func(aq *AutoMQ) {
+				aq.Spec.Broker.HostNetwork = true
+				aq.Spec.Security.Restricted = true
+			}, "spec.broker.hostNetwork", "spec.security.restricted"),
+			Entry("Missing S3 Credentials", func(aq *AutoMQ) {
+				aq.Spec.S3.Credentials = S3Credentials{}
+			}, "spec.s3.credentials.accessKeyID", "spec.s3.credentials.secretAccessKey"),
+			Entry("Secret And Inline S3 Credentials", func(aq *AutoMQ) {
+				aq.Spec.S3.Credentials.SecretName = "automq-s3"
+			}, "spec.s3.credentials", "mutually exclusive"),
+			Entry("All Errors", func(aq *AutoMQ) {
+				aq.Spec.NodePort = -1
+				aq.Spec.Controller.Env = []corev1.EnvVar{{Name: "POD_IP"}}
+				aq.Spec.AutoBalancer.Goals = []string{""}
+			}, "spec.nodePort", "spec.controller.env[0].name", "spec.autoBalancer.goals[0]"),
+		)
+		DescribeTable("Warnings",
+			func(mutate func(aq *AutoMQ), warning string) {
+				aq := initAutoMQ()
+				w := &automqWebhook{Client: k8sClient}
+				err := w.Default(context.Background(), aq)
+				Expect(err).To(BeNil())
+				mutate(aq)
+				warnings, err := w.ValidateCreate(context.Background(), aq)
+				Expect(err).To(BeNil())
+				Expect(warnings).To(ContainElement(ContainSubstring(warning)))
+			},
+			Entry("Even Controller Replicas", func(aq *AutoMQ) { aq.Spec.Controller.Replicas = 2 }, "spec.controller.replicas"),
+			Entry("NodePort Out Of Default Range", func(aq *AutoMQ) { aq.Spec.NodePort = 9092 }, "spec.nodePort"),
+			Entry("Delete All Data", func(aq *AutoMQ) { aq.Spec.DeletionPolicy = DeletionPolicyDeleteAll }, "spec.deletionPolicy"),
+			Entry("Inline S3 Credentials", func(aq *AutoMQ) {}, "spec.s3.credentials.accessKeyID is deprecated"),
+			Entry("Ignored TLSRoute", func(aq *AutoMQ) {
+				aq.Spec.Exposure.TLSRoute = &TLSRouteSpec{
+					GatewayName:           "kafka",
+					HostnameTemplate:      "b{index}.{name}.kafka.example.com",
+					BootstrapHostname:     "{name}.kafka.example.com",
+					CertificateSecretName: "automq-tls",
+				}
+			}, "spec.exposure.tlsRoute is ignored"),
+			Entry("Broker Memory Limit Nearly Full", func(aq *AutoMQ) {
+				aq.Spec.Broker.Resources.Limits = corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")}
+			}, "spec.broker.resources.limits.memory"),
+		)
+		It("Used NodePort", func() {
+			svc := &corev1.Service{
+				ObjectMeta: ctrl.ObjectMeta{Name: "used-node-port", Namespace: "default"},
+				Spec: corev1.ServiceSpec{
+					Type:  corev1.ServiceTypeNodePort,
+					Ports: []corev1.ServicePort{{Port: 80, NodePort: 31092}},
+				},
+			}
+			err := k8sClient.Create(context.Background(), svc)
+			Expect(err).To(BeNil())
+			defer func() { _ = k8sClient.Delete(context.Background(), svc) }()
+			aq := initAutoMQ()
+			aq.Spec.NodePort = 31092
+			err = k8sClient.Create(context.Background(), aq)
+			Expect(true).To(Equal(errors.IsInvalid(err)))
+			Expect(err.Error()).To(ContainSubstring("default/used-node-port"))
+		})
+		It("Duplicate Bucket And ClusterID", func() {
+			aq := initAutoMQ()
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			other := initAutoMQ()
+			other.Name = "test-other"
+			err = k8sClient.Create(context.Background(), other)
+			Expect(true).To(Equal(errors.IsInvalid(err)))
+			Expect(err.Error()).To(ContainSubstring("spec.s3.bucket"))
+			Expect(err.Error()).To(ContainSubstring("test"))
+		})
+		It("Delete All Shared Bucket", func() {
+			aq := initAutoMQ()
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			other := initAutoMQ()
+			other.Name = "test-other"
+			other.Spec.ClusterID = "other-cluster-id"
+			other.Spec.DeletionPolicy = DeletionPolicyDeleteAll
+			err = k8sClient.Create(context.Background(), other)
+			Expect(true).To(Equal(errors.IsInvalid(err)))
+			Expect(err.Error()).To(ContainSubstring("spec.deletionPolicy"))
+			Expect(err.Error()).To(ContainSubstring("default/test"))
+		})
+		It("Missing StorageClass And Secret", func() {
+			aq := initAutoMQ()
+			aq.Spec.Broker.StorageClass = "not-found"
+			aq.Spec.S3.Credentials = S3Credentials{SecretName: "not-found-s3"}
+			aq.Spec.Broker.Env = []corev1.EnvVar{{Name: "PASSWORD", ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "not-found"}, Key: "password"},
+			}}}
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(true).To(Equal(errors.IsInvalid(err)))
+			Expect(err.Error()).To(ContainSubstring("spec.broker.storageClass"))
+			Expect(err.Error()).To(ContainSubstring("spec.broker.env[0].valueFrom.secretKeyRef.name"))
+			Expect(err.Error()).To(ContainSubstring("spec.s3.credentials.secretName"))
+		})
+		It("Safe Pod Sysctl", func() {
+			aq := initAutoMQ()
+			disabled := false
+			aq.Spec.Sysctl.Enable = &disabled
+			aq.Spec.Sysctl.PodSysctls = []corev1.Sysctl{{Name: "net.ipv4.tcp_keepalive_time", Value: "7200"}}
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+		})
+		It("Default Restricted Security", func() {
+			aq := initAutoMQ()
+			aq.Spec.Security.Restricted = true
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			err = k8sClient.Get(context.Background(), client.ObjectKeyFromObject(aq), aq)
+			Expect(err).To(BeNil())
+			Expect(aq.Spec.Sysctl.IsEnabled()).To(BeFalse())
+			Expect(*aq.Spec.Security.RunAsUser).To(Equal(int64(1000)))
+			Expect(*aq.Spec.Security.FSGroup).To(Equal(int64(1000)))
+		})
+		It("Default Rack Awareness", func() {
+			aq := initAutoMQ()
+			aq.Spec.RackAwareness = &RackAwarenessSpec{}
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			err = k8sClient.Get(context.Background(), client.ObjectKeyFromObject(aq), aq)
+			Expect(err).To(BeNil())
+			Expect(aq.Spec.RackAwareness.TopologyKey).To(Equal("topology.kubernetes.io/zone"))
+		})
+		It("Delete Protected", func() {
+			aq := initAutoMQ()
+			aq.Annotations = map[string]string{DeletionProtectionAnnotation: "true"}
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			err = k8sClient.Delete(context.Background(), aq)
+			Expect(true).To(Equal(errors.IsForbidden(err)))
+			Expect(err.Error()).To(ContainSubstring(DeletionProtectionAnnotation))
+			aq.Annotations[ForceDeleteAnnotation] = "true"
+			err = k8sClient.Update(context.Background(), aq)
+			Expect(err).To(BeNil())
+			err = k8sClient.Delete(context.Background(), aq)
+			Expect(err).To(BeNil())
+		})
+		It("Delete Ready Brokers", func() {
+			aq := initAutoMQ()
+			err := k8sClient.Create(context.Background(), aq)
+			Expect(err).To(BeNil())
+			aq.Status.ReadyBrokers = "1/1"
+			aq.Status.ReadyBrokerReplicas = 1
+			err = k8sClient.Status().Update(context.Background(), aq)
+			Expect(err).To(BeNil())
+			err = k8sClient.Delete(context.Background(), aq)
+			Expect(true).To(Equal(errors.IsForbidden(err)))
+			Expect(err.Error()).To(ContainSubstring("ready brokers"))
+			aq.Annotations = map[string]string{ForceDeleteAnnotation: "true"}
+			err = k8sClient.Update(context.Background(), aq)
+			Expect(err).To(BeNil())
+			err = k8sClient.Delete(context.Background(), aq)
+			Expect(err).To(BeNil())
+		})
+	})
+})
+
+var _ = BeforeSuite(func() {
+	logf.SetLogger(zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)))
+
+	ctx, cancel = context.WithCancel(context.TODO())
+
+	By("bootstrapping test environment")
+	testEnv = &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+
+		// The BinaryAssetsDirectory is only required if you want to run the tests directly
+		// without call the makefile target test. If not informed it will look for the
+		// default path defined in controller-runtime which is /usr/local/kubebuilder/.
+		// Note that you must have the required binaries setup under the bin directory to perform
+		// the tests directly. When we run make test it will be setup and used automatically.
+		BinaryAssetsDirectory: filepath.Join("..", "..", "bin", "k8s",
+			fmt.Sprintf("1.28.0-%s-%s", runtime.GOOS, runtime.GOARCH)),
+
+		WebhookInstallOptions: envtest.WebhookInstallOptions{
+			Paths: []string{filepath.Join("..", "..", "config", "webhook")},
+		},
+	}
+
+	var err error
+	// cfg is defined in this file globally.
+	cfg, err = testEnv.Start()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cfg).NotTo(BeNil())
+
+	scheme := apimachineryruntime.NewScheme()
+	err = AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = admissionv1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = v1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = apiextensionsv1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = corev1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = storagev1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+	//+kubebuilder:scaffold:scheme
+
+	k8sClient, err = client.New(cfg, client.Options{Scheme: scheme})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(k8sClient).NotTo(BeNil())
+
+	// start webhook server using Manager
+	webhookInstallOptions := &testEnv.WebhookInstallOptions
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme: scheme,
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Host:    webhookInstallOptions.LocalServingHost,
+			Port:    webhookInstallOptions.LocalServingPort,
+			CertDir: webhookInstallOptions.LocalServingCertDir,
+		}),
+		LeaderElection: false,
+		Metrics:        metricsserver.Options{BindAddress: "0"},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&AutoMQ{}).SetupWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	//+kubebuilder:scaffold:webhook
+
+	go func() {
+		defer GinkgoRecover()
+		err = mgr.Start(ctx)
+		Expect(err).NotTo(HaveOccurred())
+	}()
+
+	// wait for the webhook server to get ready
+	dialer := &net.Dialer{Timeout: time.Second}
+	addrPort := fmt.Sprintf("%s:%d", webhookInstallOptions.LocalServingHost, webhookInstallOptions.LocalServingPort)
+	Eventually(func() error {
+		conn, err := tls.DialWithDialer(dialer, "tcp", addrPort, &tls.Config{InsecureSkipVerify: true})
+		if err != nil {
+			return err
+		}
+		conn.Close()
+		return nil
+	}).Should(Succeed())
+})
+
+var _ = AfterSuite(func() {
+	cancel()
+	By("tearing down the test environment")
+	err := testEnv.Stop()
+	Expect(err).NotTo(HaveOccurred())
+})
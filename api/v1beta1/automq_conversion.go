@@ -0,0 +1,272 @@
+/*
+Copyright 2024 cuisongliu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	v1 "github.com/cuisongliu/automq-operator/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// S3CredentialsSecretAnnotation keeps the secret name of the v1 S3 credentials, v1beta1 has only the inline access key.
+const S3CredentialsSecretAnnotation = "automq.cuisongliu.github.com/s3-credentials-secret"
+
+// ConvertTo converts the v1beta1 AutoMQ to the v1 hub.
+func (src *AutoMQ) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1.AutoMQ)
+	in := src.DeepCopy()
+	dst.ObjectMeta = in.ObjectMeta
+	secretName, ok := dst.Annotations[S3CredentialsSecretAnnotation]
+	if ok {
+		delete(dst.Annotations, S3CredentialsSecretAnnotation)
+		if len(dst.Annotations) == 0 {
+			dst.Annotations = nil
+		}
+	}
+
+	dst.Spec = v1.AutoMQSpec{
+		S3: v1.S3Spec{
+			Endpoint: in.Spec.S3.Endpoint,
+			Region:   in.Spec.S3.Region,
+			Credentials: v1.S3Credentials{
+				SecretName:      secretName,
+				AccessKeyID:     in.Spec.S3.AccessKeyID,
+				SecretAccessKey: in.Spec.S3.SecretAccessKey,
+			},
+			Bucket:          in.Spec.S3.Bucket,
+			EnablePathStyle: in.Spec.S3.EnablePathStyle,
+		},
+		DeletionPolicy:   v1.DeletionPolicy(in.Spec.DeletionPolicy),
+		ClusterID:        in.Spec.ClusterID,
+		Image:            in.Spec.Image,
+		NodePort:         in.Spec.NodePort,
+		NodeAddressTypes: in.Spec.NodeAddressTypes,
+		Exposure: v1.ExposureSpec{
+			Type:         v1.ExposureType(in.Spec.Exposure.Type),
+			LoadBalancer: (*v1.LoadBalancerSpec)(in.Spec.Exposure.LoadBalancer),
+			TLSRoute:     (*v1.TLSRouteSpec)(in.Spec.Exposure.TLSRoute),
+		},
+		Metrics:       v1.MetricsSpec(in.Spec.Metrics),
+		Mode:          v1.AutoMQMode(in.Spec.Mode),
+		Sysctl:        v1.SysctlSpec(in.Spec.Sysctl),
+		Security:      v1.SecuritySpec(in.Spec.Security),
+		AutoBalancer:  v1.AutoBalancerSpec(in.Spec.AutoBalancer),
+		RackAwareness: (*v1.RackAwarenessSpec)(in.Spec.RackAwareness),
+		Controller: v1.ControllerSpec{
+			Replicas:     in.Spec.Controller.Replicas,
+			JVMOptions:   in.Spec.Controller.JVMOptions,
+			Env:          in.Spec.Controller.Envs,
+			Resources:    in.Spec.Controller.Resource,
+			Affinity:     affinityToHub(in.Spec.Controller.Affinity),
+			StorageClass: in.Spec.Controller.StorageClass,
+			PodTemplate:  (*v1.PodTemplate)(in.Spec.Controller.PodTemplate),
+		},
+		Broker: v1.BrokerSpec{
+			Replicas:     in.Spec.Broker.Replicas,
+			JVMOptions:   in.Spec.Broker.JVMOptions,
+			Env:          in.Spec.Broker.Envs,
+			Resources:    in.Spec.Broker.Resource,
+			Affinity:     affinityToHub(in.Spec.Broker.Affinity),
+			StorageClass: in.Spec.Broker.StorageClass,
+			PodTemplate:  (*v1.PodTemplate)(in.Spec.Broker.PodTemplate),
+			Autoscaling:  (*v1.BrokerAutoscaling)(in.Spec.Broker.Autoscaling),
+			HostNetwork:  in.Spec.Broker.HostNetwork,
+		},
+	}
+
+	dst.Status = v1.AutoMQStatus{
+		Phase:                    v1.AutoMQPhase(in.Status.Phase),
+		Conditions:               in.Status.Conditions,
+		ObservedGeneration:       in.Status.ObservedGeneration,
+		ReadyPods:                in.Status.ReadyPods,
+		ReadyControllers:         in.Status.ReadyControllers,
+		ReadyBrokers:             in.Status.ReadyBrokers,
+		ReadyBrokerReplicas:      in.Status.ReadyBrokerReplicas,
+		ControllerReplicas:       in.Status.ControllerReplicas,
+		BrokerReplicas:           in.Status.BrokerReplicas,
+		BrokerSelector:           in.Status.BrokerSelector,
+		ControllerAddresses:      in.Status.ControllerAddresses,
+		BootstrapInternalAddress: in.Status.BootstrapInternalAddress,
+		BootstrapExternalAddress: in.Status.BootstrapExternalAddress,
+		PurgedObjects:            in.Status.PurgedObjects,
+		FirstReadyTime:           in.Status.FirstReadyTime,
+		BrokerIDOffset:           in.Status.BrokerIDOffset,
+	}
+	if scaling := in.Status.ControllerScaling; scaling != nil {
+		dst.Status.ControllerScaling = &v1.ControllerScalingStatus{
+			From:      scaling.From,
+			To:        scaling.To,
+			Step:      v1.ControllerScalingStep(scaling.Step),
+			Rolled:    scaling.Rolled,
+			StartTime: scaling.StartTime,
+		}
+	}
+	if scaling := in.Status.BrokerScaling; scaling != nil {
+		dst.Status.BrokerScaling = &v1.BrokerScalingStatus{
+			From:         scaling.From,
+			To:           scaling.To,
+			NodeIDs:      scaling.NodeIDs,
+			Step:         v1.BrokerScalingStep(scaling.Step),
+			StartTime:    scaling.StartTime,
+			DrainRetries: scaling.DrainRetries,
+		}
+	}
+	return nil
+}
+
+// ConvertFrom converts the v1 hub to the v1beta1 AutoMQ.
+func (dst *AutoMQ) ConvertFrom(srcRaw conversion.Hub) error {
+	in := srcRaw.(*v1.AutoMQ).DeepCopy()
+	dst.ObjectMeta = in.ObjectMeta
+	if secretName := in.Spec.S3.Credentials.SecretName; secretName != "" {
+		if dst.Annotations == nil {
+			dst.Annotations = map[string]string{}
+		}
+		dst.Annotations[S3CredentialsSecretAnnotation] = secretName
+	}
+
+	dst.Spec = AutoMQSpec{
+		S3: S3Spec{
+			Endpoint:        in.Spec.S3.Endpoint,
+			Region:          in.Spec.S3.Region,
+			AccessKeyID:     in.Spec.S3.Credentials.AccessKeyID,
+			SecretAccessKey: in.Spec.S3.Credentials.SecretAccessKey,
+			Bucket:          in.Spec.S3.Bucket,
+			EnablePathStyle: in.Spec.S3.EnablePathStyle,
+		},
+		DeletionPolicy:   DeletionPolicy(in.Spec.DeletionPolicy),
+		ClusterID:        in.Spec.ClusterID,
+		Image:            in.Spec.Image,
+		NodePort:         in.Spec.NodePort,
+		NodeAddressTypes: in.Spec.NodeAddressTypes,
+		Exposure: ExposureSpec{
+			Type:         ExposureType(in.Spec.Exposure.Type),
+			LoadBalancer: (*LoadBalancerSpec)(in.Spec.Exposure.LoadBalancer),
+			TLSRoute:     (*TLSRouteSpec)(in.Spec.Exposure.TLSRoute),
+		},
+		Metrics:       MetricsSpec(in.Spec.Metrics),
+		Mode:          AutoMQMode(in.Spec.Mode),
+		Sysctl:        SysctlSpec(in.Spec.Sysctl),
+		Security:      SecuritySpec(in.Spec.Security),
+		AutoBalancer:  AutoBalancerSpec(in.Spec.AutoBalancer),
+		RackAwareness: (*RackAwarenessSpec)(in.Spec.RackAwareness),
+		Controller: ControllerSpec{
+			Replicas:     in.Spec.Controller.Replicas,
+			JVMOptions:   in.Spec.Controller.JVMOptions,
+			Envs:         in.Spec.Controller.Env,
+			Resource:     in.Spec.Controller.Resources,
+			Affinity:     affinityFromHub(in.Spec.Controller.Affinity),
+			StorageClass: in.Spec.Controller.StorageClass,
+			PodTemplate:  (*PodTemplate)(in.Spec.Controller.PodTemplate),
+		},
+		Broker: BrokerSpec{
+			Replicas:     in.Spec.Broker.Replicas,
+			JVMOptions:   in.Spec.Broker.JVMOptions,
+			Envs:         in.Spec.Broker.Env,
+			Resource:     in.Spec.Broker.Resources,
+			Affinity:     affinityFromHub(in.Spec.Broker.Affinity),
+			StorageClass: in.Spec.Broker.StorageClass,
+			PodTemplate:  (*PodTemplate)(in.Spec.Broker.PodTemplate),
+			Autoscaling:  (*BrokerAutoscaling)(in.Spec.Broker.Autoscaling),
+			HostNetwork:  in.Spec.Broker.HostNetwork,
+		},
+	}
+
+	dst.Status = AutoMQStatus{
+		Phase:                    AutoMQPhase(in.Status.Phase),
+		Conditions:               in.Status.Conditions,
+		ObservedGeneration:       in.Status.ObservedGeneration,
+		ReadyPods:                in.Status.ReadyPods,
+		ReadyControllers:         in.Status.ReadyControllers,
+		ReadyBrokers:             in.Status.ReadyBrokers,
+		ReadyBrokerReplicas:      in.Status.ReadyBrokerReplicas,
+		ControllerReplicas:       in.Status.ControllerReplicas,
+		BrokerReplicas:           in.Status.BrokerReplicas,
+		BrokerSelector:           in.Status.BrokerSelector,
+		ControllerAddresses:      in.Status.ControllerAddresses,
+		BootstrapInternalAddress: in.Status.BootstrapInternalAddress,
+		BootstrapExternalAddress: in.Status.BootstrapExternalAddress,
+		PurgedObjects:            in.Status.PurgedObjects,
+		FirstReadyTime:           in.Status.FirstReadyTime,
+		BrokerIDOffset:           in.Status.BrokerIDOffset,
+	}
+	if scaling := in.Status.ControllerScaling; scaling != nil {
+		dst.Status.ControllerScaling = &ControllerScalingStatus{
+			From:      scaling.From,
+			To:        scaling.To,
+			Step:      ControllerScalingStep(scaling.Step),
+			Rolled:    scaling.Rolled,
+			StartTime: scaling.StartTime,
+		}
+	}
+	if scaling := in.Status.BrokerScaling; scaling != nil {
+		dst.Status.BrokerScaling = &BrokerScalingStatus{
+			From:         scaling.From,
+			To:           scaling.To,
+			NodeIDs:      scaling.NodeIDs,
+			Step:         BrokerScalingStep(scaling.Step),
+			StartTime:    scaling.StartTime,
+			DrainRetries: scaling.DrainRetries,
+		}
+	}
+	return nil
+}
+
+func affinityToHub(in *AffinitySpec) *v1.AffinitySpec {
+	if in == nil {
+		return nil
+	}
+	out := &v1.AffinitySpec{
+		PodAntiAffinity: (*v1.PodAntiAffinity)(in.PodAntiAffinity),
+		PodAffinity:     (*v1.PodAffinity)(in.PodAffinity),
+	}
+	if in.NodeAffinity != nil {
+		out.NodeAffinity = &v1.NodeAffinity{
+			Type:   in.NodeAffinity.Type,
+			Weight: in.NodeAffinity.Weight,
+		}
+		if in.NodeAffinity.NodeSelector != nil {
+			out.NodeAffinity.NodeSelector = make([]v1.NodeSelector, len(in.NodeAffinity.NodeSelector))
+			for i, selector := range in.NodeAffinity.NodeSelector {
+				out.NodeAffinity.NodeSelector[i] = v1.NodeSelector(selector)
+			}
+		}
+	}
+	return out
+}
+
+func affinityFromHub(in *v1.AffinitySpec) *AffinitySpec {
+	if in == nil {
+		return nil
+	}
+	out := &AffinitySpec{
+		PodAntiAffinity: (*PodAntiAffinity)(in.PodAntiAffinity),
+		PodAffinity:     (*PodAffinity)(in.PodAffinity),
+	}
+	if in.NodeAffinity != nil {
+		out.NodeAffinity = &NodeAffinity{
+			Type:   in.NodeAffinity.Type,
+			Weight: in.NodeAffinity.Weight,
+		}
+		if in.NodeAffinity.NodeSelector != nil {
+			out.NodeAffinity.NodeSelector = make([]NodeSelector, len(in.NodeAffinity.NodeSelector))
+			for i, selector := range in.NodeAffinity.NodeSelector {
+				out.NodeAffinity.NodeSelector[i] = NodeSelector(selector)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2024 cuisongliu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"math/rand"
+	"testing"
+
+	v1 "github.com/cuisongliu/automq-operator/api/v1"
+	fuzz "github.com/google/gofuzz"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+)
+
+const fuzzIterations = 1000
+
+func newFuzzer(t *testing.T) *fuzz.Fuzzer {
+	seed := rand.Int63()
+	t.Logf("fuzz seed %d", seed)
+	codecs := serializer.NewCodecFactory(runtime.NewScheme())
+	return fuzz.New().NilChance(0.3).NumElements(0, 3).RandSource(rand.NewSource(seed)).Funcs(
+		append(metafuzzer.Funcs(codecs),
+			func(q *resource.Quantity, c fuzz.Continue) {
+				*q = *resource.NewQuantity(c.Int63n(1<<40), resource.BinarySI)
+			},
+		)...,
+	)
+}
+
+func TestAutoMQRoundTripFromSpoke(t *testing.T) {
+	f := newFuzzer(t)
+	for i := 0; i < fuzzIterations; i++ {
+		src := &AutoMQ{}
+		f.Fuzz(src)
+		hub := &v1.AutoMQ{}
+		if err := src.ConvertTo(hub); err != nil {
+			t.Fatalf("ConvertTo() error = %v", err)
+		}
+		dst := &AutoMQ{}
+		if err := dst.ConvertFrom(hub); err != nil {
+			t.Fatalf("ConvertFrom() error = %v", err)
+		}
+		dst.TypeMeta = src.TypeMeta
+		if !apiequality.Semantic.DeepEqual(src, dst) {
+			t.Fatalf("v1beta1 -> v1 -> v1beta1 is not lossless: %s", diff.ObjectReflectDiff(src, dst))
+		}
+	}
+}
+
+func TestAutoMQRoundTripFromHub(t *testing.T) {
+	f := newFuzzer(t)
+	for i := 0; i < fuzzIterations; i++ {
+		src := &v1.AutoMQ{}
+		f.Fuzz(src)
+		// the secret name is kept in the annotation of v1beta1
+		delete(src.Annotations, S3CredentialsSecretAnnotation)
+		spoke := &AutoMQ{}
+		if err := spoke.ConvertFrom(src); err != nil {
+			t.Fatalf("ConvertFrom() error = %v", err)
+		}
+		dst := &v1.AutoMQ{}
+		if err := spoke.ConvertTo(dst); err != nil {
+			t.Fatalf("ConvertTo() error = %v", err)
+		}
+		dst.TypeMeta = src.TypeMeta
+		if !apiequality.Semantic.DeepEqual(src, dst) {
+			t.Fatalf("v1 -> v1beta1 -> v1 is not lossless: %s", diff.ObjectReflectDiff(src, dst))
+		}
+	}
+}
+
+func TestAutoMQConvertS3Credentials(t *testing.T) {
+	src := &v1.AutoMQ{}
+	src.Spec.S3.Credentials.SecretName = "automq-s3"
+	spoke := &AutoMQ{}
+	if err := spoke.ConvertFrom(src); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+	if got := spoke.Annotations[S3CredentialsSecretAnnotation]; got != "automq-s3" {
+		t.Errorf("ConvertFrom() annotation = %q, want automq-s3", got)
+	}
+	dst := &v1.AutoMQ{}
+	if err := spoke.ConvertTo(dst); err != nil {
+		t.Fatalf("ConvertTo() error = %v", err)
+	}
+	if dst.Spec.S3.Credentials.SecretName != "automq-s3" || dst.Annotations != nil {
+		t.Errorf("ConvertTo() secret name = %q and annotations = %v, want automq-s3 and no annotations",
+			dst.Spec.S3.Credentials.SecretName, dst.Annotations)
+	}
+}
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -32,11 +33,10 @@ type S3Spec struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9-]+$
 	Region string `json:"region,omitempty"`
-	// AccessKeyID is the access key ID of the S3 service
-	// +kubebuilder:validation:Required
+	// AccessKeyID is the access key ID of the S3 service. It is empty when the v1 AutoMQ reads the access key from
+	// the secret, the secret name is kept in the annotation automq.cuisongliu.github.com/s3-credentials-secret.
 	AccessKeyID string `json:"accessKeyID,omitempty"`
 	// SecretAccessKey is the secret access key of the S3 service
-	// +kubebuilder:validation:Required
 	SecretAccessKey string `json:"secretAccessKey,omitempty"`
 	// Bucket is the bucket name for storing the operations data
 	// +kubebuilder:validation:Required
@@ -98,10 +98,39 @@ type AffinitySpec struct {
 	PodAffinity *PodAffinity `json:"podAffinity,omitempty"`
 }
 
-func (in *AffinitySpec) ToK8sAffinity() *v1.Affinity {
-	affinity := &v1.Affinity{}
-	//TODO implement the conversion
-	return affinity
+// PodTemplate is the customization of the generated pods. The fields are merged into the pod spec
+// generated by the operator, fields managed by the operator always take precedence.
+type PodTemplate struct {
+	// Labels is the extra labels for the pod. The labels managed by the operator can not be overridden.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations is the extra annotations for the pod. The annotations managed by the operator can not be overridden.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Tolerations is the tolerations for the pod
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	// NodeSelector is the node selector for the pod
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// TopologySpreadConstraints is the topology spread constraints for the pod
+	TopologySpreadConstraints []v1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// PriorityClassName is the priority class name for the pod
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// ServiceAccountName is the service account name for the pod
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// SecurityContext is the pod level security context
+	SecurityContext *v1.PodSecurityContext `json:"securityContext,omitempty"`
+	// Volumes is the extra volumes for the pod
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Volumes []v1.Volume `json:"volumes,omitempty"`
+	// VolumeMounts is the extra volume mounts for the AutoMQ container
+	VolumeMounts []v1.VolumeMount `json:"volumeMounts,omitempty"`
+	// Sidecars is the extra containers running beside the AutoMQ container
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Sidecars []v1.Container `json:"sidecars,omitempty"`
+	// InitContainers is the extra init containers, they run after the init containers managed by the operator
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	InitContainers []v1.Container `json:"initContainers,omitempty"`
 }
 
 type ControllerSpec struct {
@@ -118,6 +147,8 @@ type ControllerSpec struct {
 	Affinity *AffinitySpec `json:"affinity,omitempty"`
 	// StorageClass is the storage class for the controller
 	StorageClass string `json:"storageClass,omitempty"`
+	// PodTemplate is the pod template overrides for the controller
+	PodTemplate *PodTemplate `json:"podTemplate,omitempty"`
 }
 
 type BrokerSpec struct {
@@ -134,6 +165,47 @@ type BrokerSpec struct {
 	Affinity *AffinitySpec `json:"affinity,omitempty"`
 	// StorageClass is the storage class for the controller
 	StorageClass string `json:"storageClass,omitempty"`
+	// PodTemplate is the pod template overrides for the broker
+	PodTemplate *PodTemplate `json:"podTemplate,omitempty"`
+	// Autoscaling is the autoscaling configuration for the broker, the replicas is adjusted by the operator
+	// with the metrics from Prometheus
+	Autoscaling *BrokerAutoscaling `json:"autoscaling,omitempty"`
+	// HostNetwork runs the brokers on the host network, one broker per node. The brokers bind the host ports and
+	// advertise the node address with the port 9092 instead of the node port of their services.
+	HostNetwork bool `json:"hostNetwork,omitempty"`
+}
+
+// BrokerAutoscaling is the autoscaling configuration for the broker. The desired replicas is calculated for each target
+// like the HorizontalPodAutoscaler, desiredReplicas = ceil(currentReplicas * currentValue / targetValue),
+// and the largest one is used.
+type BrokerAutoscaling struct {
+	// Enable is the flag to enable the broker autoscaling
+	Enable bool `json:"enable,omitempty"`
+	// PrometheusURL is the URL of the Prometheus to query the metrics. Default is the URL configured for the operator.
+	PrometheusURL string `json:"prometheusURL,omitempty"`
+	// MinReplicas is the lower limit of the broker replicas. Default is 1.
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit of the broker replicas
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Required
+	MaxReplicas int32 `json:"maxReplicas"`
+	// TargetNetworkInBytesPerSecond is the target average network in throughput per broker in bytes per second
+	// +kubebuilder:validation:Minimum=1
+	TargetNetworkInBytesPerSecond *int64 `json:"targetNetworkInBytesPerSecond,omitempty"`
+	// TargetNetworkOutBytesPerSecond is the target average network out throughput per broker in bytes per second
+	// +kubebuilder:validation:Minimum=1
+	TargetNetworkOutBytesPerSecond *int64 `json:"targetNetworkOutBytesPerSecond,omitempty"`
+	// TargetCPUUtilization is the target average CPU utilization per broker in percent of the requested CPU.
+	// The broker.resource.requests.cpu is required.
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilization *int32 `json:"targetCPUUtilization,omitempty"`
+	// ScaleUpStabilizationSeconds is the window in which the lowest recommendation is used when scaling up. Default is 0.
+	// +kubebuilder:validation:Minimum=0
+	ScaleUpStabilizationSeconds *int32 `json:"scaleUpStabilizationSeconds,omitempty"`
+	// ScaleDownStabilizationSeconds is the window in which the highest recommendation is used when scaling down. Default is 300.
+	// +kubebuilder:validation:Minimum=0
+	ScaleDownStabilizationSeconds *int32 `json:"scaleDownStabilizationSeconds,omitempty"`
 }
 
 // MetricsSpec is the metrics configuration for the AutoMQ
@@ -147,11 +219,179 @@ type MetricsSpec struct {
 	ImportDashboard bool `json:"importDashboard,omitempty"`
 }
 
+// SysctlSpec is the kernel parameters configuration for the AutoMQ pods
+type SysctlSpec struct {
+	// Enable is the flag to run the privileged init container that sets the host-wide sysctls. Default is true,
+	// or false when security.restricted is set.
+	// It must be disabled when the namespace enforces the restricted or baseline pod security standard.
+	Enable *bool `json:"enable,omitempty"`
+	// Image is the image of the sysctl init container. Default is the busybox image.
+	Image string `json:"image,omitempty"`
+	// Sysctls is the host-wide sysctls set by the init container, in the format of "key=value".
+	// It overrides the default list when it is not empty.
+	Sysctls []string `json:"sysctls,omitempty"`
+	// PodSysctls is the namespaced sysctls set by the pod level securityContext.sysctls.
+	// Only the safe sysctls are allowed, they do not need the privileged init container.
+	PodSysctls []v1.Sysctl `json:"podSysctls,omitempty"`
+}
+
+// IsEnabled returns whether the privileged sysctl init container is enabled.
+func (in *SysctlSpec) IsEnabled() bool {
+	return in.Enable == nil || *in.Enable
+}
+
+// SecuritySpec is the security configuration for the AutoMQ pods
+type SecuritySpec struct {
+	// Restricted is the flag to run the pods compliant with the restricted pod security standard.
+	// The pods run as non-root with a read-only root filesystem, all capabilities dropped and the RuntimeDefault
+	// seccomp profile, the kafka configuration is rendered into emptyDir volumes.
+	// The privileged sysctl init container and the host timezone mount are not available in this mode.
+	Restricted bool `json:"restricted,omitempty"`
+	// RunAsUser is the user ID to run the AutoMQ containers in restricted mode. Default is 1000.
+	// +kubebuilder:validation:Minimum=1
+	RunAsUser *int64 `json:"runAsUser,omitempty"`
+	// RunAsGroup is the group ID to run the AutoMQ containers in restricted mode. Default is 1000.
+	// +kubebuilder:validation:Minimum=0
+	RunAsGroup *int64 `json:"runAsGroup,omitempty"`
+	// FSGroup is the group ID owning the data volume in restricted mode. Default is 1000.
+	// +kubebuilder:validation:Minimum=0
+	FSGroup *int64 `json:"fsGroup,omitempty"`
+}
+
+// AutoBalancerSpec is the auto balancer configuration for the AutoMQ
+type AutoBalancerSpec struct {
+	// Enable is the flag to enable the auto balancer. Default is true.
+	Enable *bool `json:"enable,omitempty"`
+	// Goals is the list of the auto balancer goals of the controller, e.g. kafka.autobalancer.goals.NetworkInUsageDistributionGoal.
+	// Default is the goals of the AutoMQ.
+	Goals []string `json:"goals,omitempty"`
+	// ExcludeTopics is the list of the topics not balanced by the controller. Default is ["__consumer_offsets"].
+	ExcludeTopics []string `json:"excludeTopics,omitempty"`
+	// NetworkInCapacity is the inbound network bandwidth per second of a broker, e.g. 100Mi.
+	// Default is derived from the kubernetes.io/ingress-bandwidth annotation of the broker pod template, or 5Mi.
+	NetworkInCapacity *resource.Quantity `json:"networkInCapacity,omitempty"`
+	// NetworkOutCapacity is the outbound network bandwidth per second of a broker, e.g. 100Mi.
+	// Default is derived from the kubernetes.io/egress-bandwidth annotation of the broker pod template, or 5Mi.
+	NetworkOutCapacity *resource.Quantity `json:"networkOutCapacity,omitempty"`
+	// ReportingIntervalMs is the interval of the broker metrics reporting in milliseconds. Default is 5000.
+	// +kubebuilder:validation:Minimum=1000
+	ReportingIntervalMs *int32 `json:"reportingIntervalMs,omitempty"`
+}
+
+// IsEnabled returns whether the auto balancer is enabled.
+func (in *AutoBalancerSpec) IsEnabled() bool {
+	return in.Enable == nil || *in.Enable
+}
+
+// RackAwarenessSpec is the rack awareness configuration for the AutoMQ brokers
+type RackAwarenessSpec struct {
+	// TopologyKey is the label of the node used as the broker.rack of the broker running on it. Default is "topology.kubernetes.io/zone".
+	// The brokers prefer to be spread across the values of the label unless the broker pod anti-affinity is set.
+	// +kubebuilder:default="topology.kubernetes.io/zone"
+	TopologyKey string `json:"topologyKey,omitempty"`
+}
+
+// ExposureType is the type of the exposure of the brokers to the clients out of the cluster
+type ExposureType string
+
+const (
+	// ExposureTypeNodePort advertises the address of the node running the broker with the node port of the broker service
+	ExposureTypeNodePort ExposureType = "NodePort"
+	// ExposureTypeLoadBalancer advertises the ingress address of the LoadBalancer service of the broker
+	ExposureTypeLoadBalancer ExposureType = "LoadBalancer"
+	// ExposureTypeTLSRoute advertises the SNI hostname of the broker routed by a Gateway API TLSRoute
+	ExposureTypeTLSRoute ExposureType = "TLSRoute"
+)
+
+// ExposureSpec is the exposure of the brokers to the clients out of the cluster
+type ExposureSpec struct {
+	// Type is the type of the exposure. Supported values are "NodePort", "LoadBalancer" and "TLSRoute". Default is "NodePort".
+	// In LoadBalancer mode the broker services and the bootstrap service are LoadBalancer services, and a broker is
+	// started after the ingress IP or hostname is assigned to its service.
+	// In TLSRoute mode the brokers serve a TLS listener routed by the TLSRoutes of a Gateway in TLS passthrough mode,
+	// by the SNI hostname of each broker and of the bootstrap.
+	// +kubebuilder:validation:Enum=NodePort;LoadBalancer;TLSRoute
+	// +kubebuilder:default=NodePort
+	Type ExposureType `json:"type,omitempty"`
+	// LoadBalancer is the configuration of the LoadBalancer services, it is used in LoadBalancer mode.
+	// +optional
+	LoadBalancer *LoadBalancerSpec `json:"loadBalancer,omitempty"`
+	// TLSRoute is the configuration of the Gateway API TLSRoutes, it is used in TLSRoute mode.
+	// +optional
+	TLSRoute *TLSRouteSpec `json:"tlsRoute,omitempty"`
+}
+
+// LoadBalancerSpec is the configuration of the LoadBalancer services of the brokers and the bootstrap
+type LoadBalancerSpec struct {
+	// Annotations is the annotations of the LoadBalancer services, e.g. the annotations of the cloud load balancer
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// LoadBalancerClass is the class of the LoadBalancer services, it can not be changed after the services are created.
+	// +optional
+	LoadBalancerClass *string `json:"loadBalancerClass,omitempty"`
+	// LoadBalancerSourceRanges is the client CIDRs allowed by the load balancers, e.g. 10.0.0.0/8
+	// +optional
+	LoadBalancerSourceRanges []string `json:"loadBalancerSourceRanges,omitempty"`
+	// HostnameTemplate is the hostname of the broker annotated on its service for the external-dns, and advertised
+	// to the clients instead of the ingress address. "{name}", "{namespace}" and "{index}" are replaced by the name and
+	// the namespace of the AutoMQ and the index of the broker, e.g. "broker-{index}.{name}.kafka.example.com".
+	// +optional
+	HostnameTemplate string `json:"hostnameTemplate,omitempty"`
+	// BootstrapHostname is the hostname of the bootstrap service annotated for the external-dns, "{name}" and
+	// "{namespace}" are replaced as in the hostnameTemplate, e.g. "{name}.kafka.example.com".
+	// +optional
+	BootstrapHostname string `json:"bootstrapHostname,omitempty"`
+}
+
+// TLSRouteSpec is the configuration of the Gateway API TLSRoutes of the brokers and the bootstrap
+type TLSRouteSpec struct {
+	// GatewayName is the name of the Gateway the TLSRoutes are attached to. The Gateway must have a TLS listener in
+	// Passthrough mode allowing the routes from the namespace of the AutoMQ.
+	GatewayName string `json:"gatewayName"`
+	// GatewayNamespace is the namespace of the Gateway. Default is the namespace of the AutoMQ.
+	// +optional
+	GatewayNamespace string `json:"gatewayNamespace,omitempty"`
+	// SectionName is the name of the listener of the Gateway. Default is all the listeners of the Gateway.
+	// +optional
+	SectionName string `json:"sectionName,omitempty"`
+	// Port is the port of the Gateway listener advertised to the clients. Default is 443.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// HostnameTemplate is the SNI hostname of the broker advertised to the clients, "{name}", "{namespace}" and
+	// "{index}" are replaced as in the hostnameTemplate of the loadBalancer, e.g. "b{index}.{name}.kafka.example.com".
+	HostnameTemplate string `json:"hostnameTemplate"`
+	// BootstrapHostname is the SNI hostname of the bootstrap, e.g. "{name}.kafka.example.com".
+	BootstrapHostname string `json:"bootstrapHostname"`
+	// CertificateSecretName is the name of the kubernetes.io/tls secret of the TLS listener of the brokers, the
+	// certificate must be valid for the hostnames of the brokers and the bootstrap.
+	CertificateSecretName string `json:"certificateSecretName"`
+}
+
+// AutoMQMode is the deployment mode of the AutoMQ nodes
+type AutoMQMode string
+
+const (
+	// AutoMQModeSeparated runs the controller and broker roles in separate pods
+	AutoMQModeSeparated AutoMQMode = "separated"
+	// AutoMQModeCombined runs the controller and broker roles in the same server pods
+	AutoMQModeCombined AutoMQMode = "combined"
+)
+
 // AutoMQSpec defines the desired state of AutoMQ
 type AutoMQSpec struct {
 	// S3 is the S3 configuration for the AutoMQ
 	// +kubebuilder:validation:Required
 	S3 S3Spec `json:"s3,omitempty"`
+	// DeletionPolicy is the policy of the data when the AutoMQ is deleted. Supported values are "Retain", "DeletePVCs" and "DeleteAll".
+	// Retain keeps the PVCs and the S3 bucket, a new AutoMQ with the same name and cluster ID adopts them again.
+	// DeletePVCs deletes the PVCs and keeps the S3 bucket. DeleteAll deletes the PVCs, the objects of the cluster ID from the S3 bucket
+	// and the bucket when it is empty, it is rejected when the bucket is shared with other AutoMQs. Default is "DeletePVCs".
+	// +kubebuilder:validation:Enum=Retain;DeletePVCs;DeleteAll
+	// +kubebuilder:default=DeletePVCs
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
 	// ClusterID is the ID of the cluster. Default is "rZdE0DjZSrqy96PXrMUZVw"
 	// +kubebuilder:validation:Required
 	ClusterID string `json:"clusterID,omitempty"`
@@ -159,8 +399,32 @@ type AutoMQSpec struct {
 	Image string `json:"image,omitempty"`
 	// NodePort is the node port of the AutoMQ
 	NodePort int32 `json:"nodePort,omitempty"`
+	// NodeAddressTypes is the preference of the node address advertised to the clients with the node port,
+	// the first type found in the addresses of the node running the broker is used. Supported values are
+	// "ExternalIP", "InternalIP" and "Hostname". Default is ["InternalIP"].
+	// +optional
+	NodeAddressTypes []v1.NodeAddressType `json:"nodeAddressTypes,omitempty"`
+	// Exposure is the exposure of the brokers to the clients out of the cluster. Default is the NodePort exposure.
+	// +optional
+	Exposure ExposureSpec `json:"exposure,omitempty"`
 	// Metrics is the metrics configuration for the AutoMQ
 	Metrics MetricsSpec `json:"metrics,omitempty"`
+	// Mode is the deployment mode of the AutoMQ. Supported values are "separated" and "combined". Default is "separated".
+	// In combined mode the broker pods run both the controller and broker roles (process.roles=broker,controller),
+	// the broker configuration is used for the server nodes and the controller configuration is ignored.
+	// The mode can not be changed after the cluster is created.
+	// +kubebuilder:validation:Enum=separated;combined
+	// +kubebuilder:default=separated
+	Mode AutoMQMode `json:"mode,omitempty"`
+	// Sysctl is the kernel parameters configuration for the AutoMQ pods
+	Sysctl SysctlSpec `json:"sysctl,omitempty"`
+	// Security is the security configuration for the AutoMQ pods
+	Security SecuritySpec `json:"security,omitempty"`
+	// AutoBalancer is the auto balancer configuration for the AutoMQ
+	AutoBalancer AutoBalancerSpec `json:"autoBalancer,omitempty"`
+	// RackAwareness is the rack awareness configuration for the AutoMQ brokers. The broker.rack is set from the label
+	// of the node running the broker, and the consumers with the client.rack fetch from the brokers in the same rack.
+	RackAwareness *RackAwarenessSpec `json:"rackAwareness,omitempty"`
 	// Controller is the controller configuration for the AutoMQ
 	// +kubebuilder:validation:Required
 	Controller ControllerSpec `json:"controller,omitempty"`
@@ -169,6 +433,39 @@ type AutoMQSpec struct {
 	Broker BrokerSpec `json:"broker,omitempty"`
 }
 
+// DeletionPolicy is the policy of the PVCs and the S3 data when the AutoMQ is deleted
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyRetain keeps the PVCs and the S3 data
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+	// DeletionPolicyDeletePVCs deletes the PVCs and keeps the S3 data
+	DeletionPolicyDeletePVCs DeletionPolicy = "DeletePVCs"
+	// DeletionPolicyDeleteAll deletes the PVCs and purges the S3 bucket
+	DeletionPolicyDeleteAll DeletionPolicy = "DeleteAll"
+)
+
+const (
+	// PausedAnnotation is the annotation to pause the reconciliation of the AutoMQ. When it is "true",
+	// the operator does not change the resources of the AutoMQ and only updates the status.
+	PausedAnnotation = "automq.cuisongliu.github.com/paused"
+	// DeletionProtectionAnnotation is the annotation to reject the deletion of the AutoMQ when it is "true".
+	DeletionProtectionAnnotation = "automq.cuisongliu.github.com/deletion-protection"
+	// ForceDeleteAnnotation is the annotation to allow the deletion of the AutoMQ when it is "true", even if
+	// the AutoMQ is protected or has ready brokers.
+	ForceDeleteAnnotation = "automq.cuisongliu.github.com/force-delete"
+)
+
+// IsPaused returns true when the reconciliation is paused by the annotation
+func (in *AutoMQ) IsPaused() bool {
+	return in.GetAnnotations()[PausedAnnotation] == "true"
+}
+
+// IsCombined returns true when the controller and broker roles run in the same server pods
+func (in *AutoMQ) IsCombined() bool {
+	return in.Spec.Mode == AutoMQModeCombined
+}
+
 type AutoMQPhase string
 
 // These are the valid phases of node.
@@ -177,8 +474,83 @@ const (
 	AutoMQError     AutoMQPhase = "Error"
 	AutoMQReady     AutoMQPhase = "Ready"
 	AutoMQInProcess AutoMQPhase = "InProcess"
+	// AutoMQCreating is the phase before all the nodes are ready for the first time
+	AutoMQCreating AutoMQPhase = "Creating"
+	// AutoMQScaling is the phase when the controllers or brokers are scaling
+	AutoMQScaling AutoMQPhase = "Scaling"
+	// AutoMQUpgrading is the phase when the spec changes are rolling out to the nodes
+	AutoMQUpgrading AutoMQPhase = "Upgrading"
+	// AutoMQDegraded is the phase when some nodes of a created cluster are not ready
+	AutoMQDegraded AutoMQPhase = "Degraded"
+	// AutoMQDeleting is the phase when the AutoMQ is being deleted
+	AutoMQDeleting AutoMQPhase = "Deleting"
+	// AutoMQPaused is the phase when the reconciliation is paused
+	AutoMQPaused AutoMQPhase = "Paused"
 )
 
+// ConditionPaused is the type of the condition reporting the reconciliation is paused by the annotation.
+const ConditionPaused = "Paused"
+
+// ConditionReady is the type of the condition summarizing whether the AutoMQ is ready to serve,
+// its reason is the phase of the AutoMQ.
+const ConditionReady = "Ready"
+
+// ControllerScalingStep is the step of the controller quorum scaling
+type ControllerScalingStep string
+
+const (
+	// ControllerScalingStopping stops and deletes the removed controllers
+	ControllerScalingStopping ControllerScalingStep = "StoppingControllers"
+	// ControllerScalingRolling restarts the kept controllers one by one with the new voters
+	ControllerScalingRolling ControllerScalingStep = "RollingControllers"
+	// ControllerScalingStarting starts the added controllers and rolls the brokers with the new voters
+	ControllerScalingStarting ControllerScalingStep = "StartingControllers"
+)
+
+// ControllerScalingStatus is the progress of the controller quorum scaling
+type ControllerScalingStatus struct {
+	// From is the number of controller replicas before the scaling
+	From int32 `json:"from"`
+	// To is the number of controller replicas after the scaling
+	To int32 `json:"to"`
+	// Step is the current step of the scaling
+	Step ControllerScalingStep `json:"step"`
+	// Rolled is the number of the kept controllers restarted with the new voters
+	// +optional
+	Rolled int32 `json:"rolled,omitempty"`
+	// StartTime is the time the scaling started
+	StartTime metav1.Time `json:"startTime,omitempty"`
+}
+
+// BrokerScalingStep is the step of the broker scale-down
+type BrokerScalingStep string
+
+const (
+	// BrokerScalingReassigning moves the partitions off the removed brokers
+	BrokerScalingReassigning BrokerScalingStep = "ReassigningPartitions"
+	// BrokerScalingStopping stops the removed brokers
+	BrokerScalingStopping BrokerScalingStep = "StoppingBrokers"
+	// BrokerScalingUnregistering unregisters the removed brokers from the controller quorum
+	BrokerScalingUnregistering BrokerScalingStep = "UnregisteringBrokers"
+)
+
+// BrokerScalingStatus is the progress of the broker scale-down
+type BrokerScalingStatus struct {
+	// From is the number of broker replicas before the scaling
+	From int32 `json:"from"`
+	// To is the number of broker replicas after the scaling
+	To int32 `json:"to"`
+	// NodeIDs is the node ids of the removed brokers
+	NodeIDs []int32 `json:"nodeIDs,omitempty"`
+	// Step is the current step of the scaling
+	Step BrokerScalingStep `json:"step"`
+	// StartTime is the time the scaling started
+	StartTime metav1.Time `json:"startTime,omitempty"`
+	// DrainRetries is the number of the failed drain jobs of the current step, the failed job is created again
+	// after a backoff growing with the retries
+	DrainRetries int32 `json:"drainRetries,omitempty"`
+}
+
 // AutoMQStatus defines the observed state of AutoMQ
 type AutoMQStatus struct {
 	// Phase represents the current phase of AutoMQ.
@@ -187,11 +559,23 @@ type AutoMQStatus struct {
 	// Conditions contains the different condition statuses for this automq.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions"`
+	// ObservedGeneration is the generation of the spec the nodes are fully reconciled with
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	// ReadyPods is the number of ready pods for the AutoMQ
 	// +optional
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:default=0
 	ReadyPods int32 `json:"readyPods"`
+	// ReadyControllers is the number of the ready and desired controller pods for the AutoMQ, e.g. 2/3
+	// +optional
+	ReadyControllers string `json:"readyControllers,omitempty"`
+	// ReadyBrokers is the number of the ready and desired broker pods for the AutoMQ, e.g. 2/3
+	// +optional
+	ReadyBrokers string `json:"readyBrokers,omitempty"`
+	// ReadyBrokerReplicas is the number of the ready broker pods for the AutoMQ
+	// +optional
+	ReadyBrokerReplicas int32 `json:"readyBrokerReplicas,omitempty"`
 	// ControllerReplicas is the number of controller replicas for the AutoMQ
 	// +optional
 	// +kubebuilder:validation:Minimum=0
@@ -202,19 +586,47 @@ type AutoMQStatus struct {
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:default=0
 	BrokerReplicas int32 `json:"brokerReplicas"`
+	// ControllerScaling is the progress of the controller quorum scaling, it is empty when no scaling is in progress
+	// +optional
+	ControllerScaling *ControllerScalingStatus `json:"controllerScaling,omitempty"`
+	// BrokerScaling is the progress of the broker scale-down, it is empty when no scale-down is in progress
+	// +optional
+	BrokerScaling *BrokerScalingStatus `json:"brokerScaling,omitempty"`
+	// BrokerIDOffset is the node id of the first broker, it is saved when the AutoMQ is created so the node ids of
+	// the brokers are kept when the controllers are scaled. The controllers take the node ids below it.
+	// +optional
+	BrokerIDOffset *int32 `json:"brokerIDOffset,omitempty"`
+	// BrokerSelector is the label selector of the broker pods, it is used by the scale subresource
+	// +optional
+	BrokerSelector string `json:"brokerSelector,omitempty"`
 	// ControllerAddress is the address of the controller
 	// +optional
 	ControllerAddresses []string `json:"controllerAddresses,omitempty"`
 	// BootstrapInternalAddress is the address of the bootstrap
 	// +optional
 	BootstrapInternalAddress string `json:"bootstrapInternalAddress,omitempty"`
+	// BootstrapExternalAddress is the address of the bootstrap LoadBalancer service in LoadBalancer mode
+	// +optional
+	BootstrapExternalAddress string `json:"bootstrapExternalAddress,omitempty"`
+	// FirstReadyTime is the time the AutoMQ is ready for the first time, the AutoMQ is degraded instead of creating
+	// when the pods are not ready afterwards
+	// +optional
+	FirstReadyTime *metav1.Time `json:"firstReadyTime,omitempty"`
+	// PurgedObjects is the number of the S3 objects deleted by the DeleteAll deletion policy
+	// +optional
+	PurgedObjects int64 `json:"purgedObjects,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:subresource:scale:specpath=.spec.broker.replicas,statuspath=.status.brokerReplicas,selectorpath=.status.brokerSelector
 // +kubebuilder:resource:scope=Namespaced
-// +kubebuilder:printcolumn:name="Ready Pods",type=string,JSONPath=`.status.readyPods`
+// +kubebuilder:deprecatedversion:warning="infra.cuisongliu.github.com/v1beta1 AutoMQ is deprecated, use infra.cuisongliu.github.com/v1 AutoMQ"
+// +kubebuilder:printcolumn:name="Ready Pods",type=string,JSONPath=`.status.readyPods`,priority=1
+// +kubebuilder:printcolumn:name="Controllers",type=string,JSONPath=`.status.readyControllers`
+// +kubebuilder:printcolumn:name="Brokers",type=string,JSONPath=`.status.readyBrokers`
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Bootstrap",type=string,JSONPath=`.status.bootstrapInternalAddress`
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // AutoMQ is the Schema for the automqs API
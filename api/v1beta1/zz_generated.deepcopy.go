@@ -23,7 +23,7 @@ package v1beta1
 import (
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -56,6 +56,51 @@ func (in *AffinitySpec) DeepCopy() *AffinitySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoBalancerSpec) DeepCopyInto(out *AutoBalancerSpec) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Goals != nil {
+		in, out := &in.Goals, &out.Goals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeTopics != nil {
+		in, out := &in.ExcludeTopics, &out.ExcludeTopics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkInCapacity != nil {
+		in, out := &in.NetworkInCapacity, &out.NetworkInCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.NetworkOutCapacity != nil {
+		in, out := &in.NetworkOutCapacity, &out.NetworkOutCapacity
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ReportingIntervalMs != nil {
+		in, out := &in.ReportingIntervalMs, &out.ReportingIntervalMs
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoBalancerSpec.
+func (in *AutoBalancerSpec) DeepCopy() *AutoBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutoMQ) DeepCopyInto(out *AutoMQ) {
 	*out = *in
@@ -119,7 +164,21 @@ func (in *AutoMQList) DeepCopyObject() runtime.Object {
 func (in *AutoMQSpec) DeepCopyInto(out *AutoMQSpec) {
 	*out = *in
 	out.S3 = in.S3
+	if in.NodeAddressTypes != nil {
+		in, out := &in.NodeAddressTypes, &out.NodeAddressTypes
+		*out = make([]v1.NodeAddressType, len(*in))
+		copy(*out, *in)
+	}
+	in.Exposure.DeepCopyInto(&out.Exposure)
 	out.Metrics = in.Metrics
+	in.Sysctl.DeepCopyInto(&out.Sysctl)
+	in.Security.DeepCopyInto(&out.Security)
+	in.AutoBalancer.DeepCopyInto(&out.AutoBalancer)
+	if in.RackAwareness != nil {
+		in, out := &in.RackAwareness, &out.RackAwareness
+		*out = new(RackAwarenessSpec)
+		**out = **in
+	}
 	in.Controller.DeepCopyInto(&out.Controller)
 	in.Broker.DeepCopyInto(&out.Broker)
 }
@@ -144,11 +203,30 @@ func (in *AutoMQStatus) DeepCopyInto(out *AutoMQStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ControllerScaling != nil {
+		in, out := &in.ControllerScaling, &out.ControllerScaling
+		*out = new(ControllerScalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BrokerScaling != nil {
+		in, out := &in.BrokerScaling, &out.BrokerScaling
+		*out = new(BrokerScalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BrokerIDOffset != nil {
+		in, out := &in.BrokerIDOffset, &out.BrokerIDOffset
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ControllerAddresses != nil {
 		in, out := &in.ControllerAddresses, &out.ControllerAddresses
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.FirstReadyTime != nil {
+		in, out := &in.FirstReadyTime, &out.FirstReadyTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoMQStatus.
@@ -161,6 +239,67 @@ func (in *AutoMQStatus) DeepCopy() *AutoMQStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerAutoscaling) DeepCopyInto(out *BrokerAutoscaling) {
+	*out = *in
+	if in.TargetNetworkInBytesPerSecond != nil {
+		in, out := &in.TargetNetworkInBytesPerSecond, &out.TargetNetworkInBytesPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetNetworkOutBytesPerSecond != nil {
+		in, out := &in.TargetNetworkOutBytesPerSecond, &out.TargetNetworkOutBytesPerSecond
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetCPUUtilization != nil {
+		in, out := &in.TargetCPUUtilization, &out.TargetCPUUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleUpStabilizationSeconds != nil {
+		in, out := &in.ScaleUpStabilizationSeconds, &out.ScaleUpStabilizationSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleDownStabilizationSeconds != nil {
+		in, out := &in.ScaleDownStabilizationSeconds, &out.ScaleDownStabilizationSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerAutoscaling.
+func (in *BrokerAutoscaling) DeepCopy() *BrokerAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerScalingStatus) DeepCopyInto(out *BrokerScalingStatus) {
+	*out = *in
+	if in.NodeIDs != nil {
+		in, out := &in.NodeIDs, &out.NodeIDs
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerScalingStatus.
+func (in *BrokerScalingStatus) DeepCopy() *BrokerScalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerScalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BrokerSpec) DeepCopyInto(out *BrokerSpec) {
 	*out = *in
@@ -182,6 +321,16 @@ func (in *BrokerSpec) DeepCopyInto(out *BrokerSpec) {
 		*out = new(AffinitySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(PodTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(BrokerAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerSpec.
@@ -194,6 +343,22 @@ func (in *BrokerSpec) DeepCopy() *BrokerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerScalingStatus) DeepCopyInto(out *ControllerScalingStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerScalingStatus.
+func (in *ControllerScalingStatus) DeepCopy() *ControllerScalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerScalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerSpec) DeepCopyInto(out *ControllerSpec) {
 	*out = *in
@@ -215,6 +380,11 @@ func (in *ControllerSpec) DeepCopyInto(out *ControllerSpec) {
 		*out = new(AffinitySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(PodTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerSpec.
@@ -227,6 +397,63 @@ func (in *ControllerSpec) DeepCopy() *ControllerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExposureSpec) DeepCopyInto(out *ExposureSpec) {
+	*out = *in
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSRoute != nil {
+		in, out := &in.TLSRoute, &out.TLSRoute
+		*out = new(TLSRouteSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureSpec.
+func (in *ExposureSpec) DeepCopy() *ExposureSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExposureSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LoadBalancerClass != nil {
+		in, out := &in.LoadBalancerClass, &out.LoadBalancerClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.LoadBalancerSourceRanges != nil {
+		in, out := &in.LoadBalancerSourceRanges, &out.LoadBalancerSourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
+func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsSpec) DeepCopyInto(out *MetricsSpec) {
 	*out = *in
@@ -314,6 +541,104 @@ func (in *PodAntiAffinity) DeepCopy() *PodAntiAffinity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodTemplate) DeepCopyInto(out *PodTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTemplate.
+func (in *PodTemplate) DeepCopy() *PodTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(PodTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackAwarenessSpec) DeepCopyInto(out *RackAwarenessSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackAwarenessSpec.
+func (in *RackAwarenessSpec) DeepCopy() *RackAwarenessSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RackAwarenessSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3Spec) DeepCopyInto(out *S3Spec) {
 	*out = *in
@@ -328,3 +653,78 @@ func (in *S3Spec) DeepCopy() *S3Spec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuritySpec) DeepCopyInto(out *SecuritySpec) {
+	*out = *in
+	if in.RunAsUser != nil {
+		in, out := &in.RunAsUser, &out.RunAsUser
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RunAsGroup != nil {
+		in, out := &in.RunAsGroup, &out.RunAsGroup
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FSGroup != nil {
+		in, out := &in.FSGroup, &out.FSGroup
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuritySpec.
+func (in *SecuritySpec) DeepCopy() *SecuritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SysctlSpec) DeepCopyInto(out *SysctlSpec) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodSysctls != nil {
+		in, out := &in.PodSysctls, &out.PodSysctls
+		*out = make([]v1.Sysctl, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SysctlSpec.
+func (in *SysctlSpec) DeepCopy() *SysctlSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SysctlSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSRouteSpec) DeepCopyInto(out *TLSRouteSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSRouteSpec.
+func (in *TLSRouteSpec) DeepCopy() *TLSRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
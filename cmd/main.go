@@ -18,11 +18,18 @@ package main
 
 import (
 	"flag"
+	"net"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
 	infrav1beta1 "github.com/cuisongliu/automq-operator/api/v1beta1"
 	"github.com/cuisongliu/automq-operator/internal/controller"
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -48,6 +55,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(infrav1beta1.AddToScheme(scheme))
+	utilruntime.Must(infrav1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 	utilruntime.Must(promv1.AddToScheme(scheme))
 }
@@ -58,8 +66,17 @@ func main() {
 	var probeAddr string
 	var rateLimiterOptions utilcontroller.RateLimiterOptions
 	var mountTZ bool
+	var prometheusURL string
+	var autoscalerInterval time.Duration
+	var apisAddr string
+	var apisCertDir string
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.BoolVar(&mountTZ, "mount-tz", false, "Mount the /etc/localtime file from the host to the container.")
+	flag.StringVar(&prometheusURL, "prometheus-url", "", "The default URL of the Prometheus used by the broker autoscaling.")
+	flag.DurationVar(&autoscalerInterval, "autoscaler-interval", 30*time.Second, "The period of the broker autoscaling evaluation.")
+	flag.StringVar(&apisAddr, "apis-bind-address", ":9090", "The address the operator APIs called by the AutoMQ pods bind to.")
+	flag.StringVar(&apisCertDir, "apis-cert-dir", "",
+		"The directory of the tls.crt, tls.key and ca.crt serving the operator APIs over TLS, the APIs are served over HTTP if empty.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
@@ -97,39 +114,89 @@ func main() {
 		os.Exit(1)
 	}
 
+	if os.Getenv("NAMESPACE_NAME") == "" {
+		_ = os.Setenv("NAMESPACE_NAME", "default")
+	}
+
+	ctx := ctrl.SetupSignalHandler()
+
+	_, apisPortStr, err := net.SplitHostPort(apisAddr)
+	if err != nil {
+		setupLog.Error(err, "invalid apis bind address", "address", apisAddr)
+		os.Exit(1)
+	}
+	apisPort, err := strconv.ParseInt(apisPortStr, 10, 32)
+	if err != nil {
+		setupLog.Error(err, "invalid apis bind address", "address", apisAddr)
+		os.Exit(1)
+	}
+	// OPERATOR_APIS_IP overrides the service of the operator apis, e.g. the operator running out of the cluster
+	apisHost := os.Getenv("OPERATOR_APIS_IP")
+	if apisHost == "" {
+		if os.Getenv("POD_NAME") == "" {
+			setupLog.Error(nil, "POD_NAME is required to create the service of the operator apis unless OPERATOR_APIS_IP is set")
+			os.Exit(1)
+		}
+		apisService, err := controller.NewAPIsService(ctx, mgr.GetAPIReader(), os.Getenv("NAMESPACE_NAME"), os.Getenv("POD_NAME"), int32(apisPort))
+		if err != nil {
+			setupLog.Error(err, "unable to discover the operator deployment")
+			os.Exit(1)
+		}
+		apisService.Client = mgr.GetClient()
+		if err = mgr.Add(apisService); err != nil {
+			setupLog.Error(err, "unable to add the service of the operator apis")
+			os.Exit(1)
+		}
+		apisHost = apisService.Host()
+	}
+	apisAddress := url.URL{Scheme: "http", Host: net.JoinHostPort(apisHost, apisPortStr)}
+	var apisCAFile string
+	if apisCertDir != "" {
+		apisAddress.Scheme = "https"
+		apisCAFile = filepath.Join(apisCertDir, "ca.crt")
+	}
+
 	if err = (&controller.AutoMQReconciler{
-		Finalizer: "apps.cuisongliu.com/automq.finalizer",
-		MountTZ:   mountTZ,
+		Finalizer:   "apps.cuisongliu.com/automq.finalizer",
+		MountTZ:     mountTZ,
+		APIsAddress: apisAddress.String(),
+		APIsCAFile:  apisCAFile,
 	}).SetupWithManager(mgr, rateLimiterOptions); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AutoMQ")
 		os.Exit(1)
 	}
+	if err = (&controller.AutoMQAutoscalerReconciler{
+		PrometheusURL: prometheusURL,
+		Interval:      autoscalerInterval,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AutoMQAutoscaler")
+		os.Exit(1)
+	}
 	if ew, _ := os.LookupEnv("ENABLE_WEBHOOKS"); ew != "false" {
 		if err = (&infrav1beta1.AutoMQ{}).SetupWebhookWithManager(mgr); err != nil {
-			setupLog.Error(err, "unable to create webhook", "webhook", "AutoMQ")
+			setupLog.Error(err, "unable to create webhook", "webhook", "AutoMQ", "version", "v1beta1")
+			os.Exit(1)
+		}
+		if err = (&infrav1.AutoMQ{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AutoMQ", "version", "v1")
 			os.Exit(1)
 		}
 	}
 
-	if os.Getenv("OPERATOR_APIS_IP") == "" {
-		setupLog.Error(err, "OPERATOR_APIS_IP is empty")
-		os.Exit(1)
+	// the APIs are served by every replica, the AutoMQ pods call them before the leader is elected
+	apiServer := &controller.APIServer{
+		Client:      mgr.GetClient(),
+		APIReader:   mgr.GetAPIReader(),
+		BindAddress: apisAddr,
+		CertDir:     apisCertDir,
 	}
-
-	if os.Getenv("NAMESPACE_NAME") == "" {
-		_ = os.Setenv("NAMESPACE_NAME", "default")
+	if err = mgr.Add(apiServer); err != nil {
+		setupLog.Error(err, "unable to add the operator apis")
+		os.Exit(1)
 	}
 
 	//+kubebuilder:scaffold:builder
 
-	ctx := ctrl.SetupSignalHandler()
-
-	go func() {
-		if mgr.GetCache().WaitForCacheSync(ctx) {
-			controller.APIRegistry(ctx, mgr.GetClient())
-		}
-	}()
-
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -138,6 +205,10 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if err := mgr.AddReadyzCheck("apis", apiServer.ReadyzCheck); err != nil {
+		setupLog.Error(err, "unable to set up apis ready check")
+		os.Exit(1)
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctx); err != nil {
@@ -0,0 +1,139 @@
+#!/usr/bin/env bash
+
+# Copyright 2024 cuisongliu@qq.com.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#    http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# drain: remove the brokers from the cluster.
+#
+# Usage:
+#   drain.sh reassign BOOTSTRAP_SERVER DRAIN_NODE_IDS REMAIN_NODE_IDS
+#     move the partitions with the replicas on the draining brokers to the remaining brokers.
+#   drain.sh unregister BOOTSTRAP_SERVER DRAIN_NODE_IDS
+#     unregister the stopped brokers from the controller quorum.
+#   the node ids are separated by commas.
+
+set -o pipefail
+
+action="${1}"
+bootstrap_server="${2}"
+drain_node_ids="${3}"
+remain_node_ids="${4}"
+
+kafka_bin="${KAFKA_BIN:-/opt/kafka/kafka/bin}"
+work_dir="$(mktemp -d)"
+verify_timeout="${DRAIN_VERIFY_TIMEOUT:-600}"
+
+# Exit with an error message.
+die() {
+  echo "$@"
+  exit 1
+}
+
+# print the reassignment of the partitions with the replicas on the draining brokers, the replicas on the
+# draining brokers are replaced by the remaining brokers in turn, the other partitions are not moved.
+drain_reassignment() {
+  awk -v drain="${drain_node_ids}" -v remain="${remain_node_ids}" '
+    BEGIN {
+      n = split(drain, ids, ",")
+      for (i = 1; i <= n; i++) draining[ids[i]] = 1
+      remain_count = split(remain, remaining, ",")
+      next_remain = 0
+      count = 0
+      printf "{\"version\":1,\"partitions\":["
+    }
+    /Partition:/ {
+      topic = ""; partition = ""; replicas = ""
+      for (i = 1; i <= NF; i++) {
+        if ($i == "Topic:") topic = $(i + 1)
+        else if ($i == "Partition:") partition = $(i + 1)
+        else if ($i == "Replicas:") replicas = $(i + 1)
+      }
+      replica_count = split(replicas, current, ",")
+      moved = 0
+      for (i = 1; i <= replica_count; i++) if (current[i] in draining) moved = 1
+      if (!moved) next
+      delete assigned
+      for (i = 1; i <= replica_count; i++) if (!(current[i] in draining)) assigned[current[i]] = 1
+      target = ""
+      for (i = 1; i <= replica_count; i++) {
+        replica = current[i]
+        if (replica in draining) {
+          replica = ""
+          for (j = 0; j < remain_count; j++) {
+            candidate = remaining[(next_remain + j) % remain_count + 1]
+            if (!(candidate in assigned)) {
+              replica = candidate
+              next_remain = (next_remain + j + 1) % remain_count
+              break
+            }
+          }
+          if (replica == "") continue
+          assigned[replica] = 1
+        }
+        target = target (target == "" ? "" : ",") replica
+      }
+      printf "%s{\"topic\":\"%s\",\"partition\":%s,\"replicas\":[%s]}", (count++ ? "," : ""), topic, partition, target
+    }
+    END { print "]}" }
+  ' "${work_dir}/describe.out"
+}
+
+reassign() {
+  [[ -n "${remain_node_ids}" ]] || die "remain_node_ids is empty"
+  echo "drain: move partitions from brokers ${drain_node_ids} to ${remain_node_ids}"
+
+  "${kafka_bin}/kafka-topics.sh" --bootstrap-server "${bootstrap_server}" --describe >"${work_dir}/describe.out" ||
+    die "drain: failed to describe topics"
+  drain_reassignment >"${work_dir}/reassignment.json" || die "drain: failed to generate the reassignment"
+  if grep -q '"partitions":\[\]' "${work_dir}/reassignment.json"; then
+    echo "drain: no partitions on brokers ${drain_node_ids}"
+    return 0
+  fi
+
+  # AutoMQ keeps the partition data in S3, the reassignment only changes the metadata
+  "${kafka_bin}/kafka-reassign-partitions.sh" --bootstrap-server "${bootstrap_server}" \
+    --reassignment-json-file "${work_dir}/reassignment.json" --execute || die "drain: failed to execute the reassignment"
+
+  start=$(date +%s)
+  while true; do
+    out=$("${kafka_bin}/kafka-reassign-partitions.sh" --bootstrap-server "${bootstrap_server}" \
+      --reassignment-json-file "${work_dir}/reassignment.json" --verify) || die "drain: failed to verify the reassignment"
+    if ! echo "${out}" | grep -q "still in progress"; then
+      break
+    fi
+    if (($(date +%s) - start > verify_timeout)); then
+      die "drain: the reassignment is not finished in ${verify_timeout} seconds"
+    fi
+    echo "drain: waiting for the reassignment"
+    sleep 5
+  done
+  echo "drain: the reassignment is finished"
+}
+
+unregister() {
+  for node_id in ${drain_node_ids//,/ }; do
+    "${kafka_bin}/kafka-cluster.sh" unregister --bootstrap-server "${bootstrap_server}" --id "${node_id}" ||
+      die "drain: failed to unregister broker ${node_id}"
+    echo "drain: broker ${node_id} is unregistered"
+  done
+}
+
+[[ -n "${bootstrap_server}" ]] || die "bootstrap_server is empty"
+[[ -n "${drain_node_ids}" ]] || die "drain_node_ids is empty"
+
+case "${action}" in
+reassign) reassign ;;
+unregister) unregister ;;
+*) die "drain: unknown action ${action}" ;;
+esac
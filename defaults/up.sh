@@ -20,7 +20,7 @@ script_path="${0}"
 # The absolute path to the directory which this script is in.
 start_dir="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
 
-run_info_file="${start_dir}/run.info"
+run_info_file="${AUTOMQ_RUN_INFO_FILE:-${start_dir}/run.info}"
 
 # The absolute path to the root Kafka directory
 kafka_dir="$( cd "${start_dir}/../kafka" && pwd )"
@@ -179,6 +179,17 @@ turn_on_auto_balancer() {
 }
 
 
+# call the operator apis of this automq with the token, the apis are verified by the CA when served over TLS
+operator_apis() {
+    path=$1
+    shift
+    if [[ -n "${OPERATOR_APIS_CA}" ]]; then
+        set -- --cacert <(printf '%s' "${OPERATOR_APIS_CA}") "$@"
+    fi
+    curl -f -s -H "Authorization: Bearer ${OPERATOR_APIS_TOKEN}" "$@" \
+        "${OPERATOR_APIS_ADDR}/api/v1/namespaces/${NAMESPACE_NAME}/automqs/${AUTOMQ_NAME}/${path}"
+}
+
 # monitor and change advertised ip for kafka
 kafka_monitor_ip() {
     process_role=$(grep "role" "${run_info_file}" | awk -F= '{print $2}')
@@ -186,14 +197,13 @@ kafka_monitor_ip() {
 
     # get private ip first
     local_private_ip="0.0.0.0"
-    advertised_ip_port="${local_private_ip}:9092"
+    advertised_listeners="PLAINTEXT://${local_private_ip}:9092"
     if [[ -n "${OPERATOR_APIS_ADDR}" ]]; then
-        node_ip=$(curl -f -s "${OPERATOR_APIS_ADDR}/api/v1/nodes/${NODE_NAME}")
-        if [[ $? -eq 0 && -n "$node_ip" ]]; then
-            echo "kafka_monitor_ip: node_ip=${node_ip}"
-            advertised_ip_port="${node_ip}:${NODEPORT_DEFAULT_PORT}"
+        advertised_listeners=$(operator_apis "pods/${POD_NAME}/advertised-listeners")
+        if [[ $? -eq 0 && -n "${advertised_listeners}" ]]; then
+            echo "kafka_monitor_ip: advertised_listeners=${advertised_listeners}"
         else
-            echo "Failed to retrieve node_ip from ${OPERATOR_APIS_ADDR}"
+            echo "Failed to retrieve advertised_listeners from ${OPERATOR_APIS_ADDR}"
             exit 1
         fi
     fi
@@ -202,10 +212,10 @@ kafka_monitor_ip() {
     # change ip settings for this node
     if [[ "${process_role}" == "server" ]]; then
         setup_value "listeners" "PLAINTEXT://${local_private_ip}:9092,CONTROLLER://${local_private_ip}:9093" "${kafka_dir}/config/kraft/${process_role}.properties"
-        setup_value "advertised.listeners" "PLAINTEXT://${advertised_ip_port}" "${kafka_dir}/config/kraft/${process_role}.properties"
+        setup_value "advertised.listeners" "${advertised_listeners}" "${kafka_dir}/config/kraft/${process_role}.properties"
     elif [[ "${process_role}" == "broker" ]]; then
         setup_value "listeners" "PLAINTEXT://${local_private_ip}:9092" "${kafka_dir}/config/kraft/${process_role}.properties"
-        setup_value "advertised.listeners" "PLAINTEXT://${advertised_ip_port}" "${kafka_dir}/config/kraft/${process_role}.properties"
+        setup_value "advertised.listeners" "${advertised_listeners}" "${kafka_dir}/config/kraft/${process_role}.properties"
     elif [[ "${process_role}" == "controller" ]]; then
         setup_value "listeners" "CONTROLLER://${local_private_ip}:9093" "${kafka_dir}/config/kraft/${process_role}.properties"
     else
@@ -213,6 +223,56 @@ kafka_monitor_ip() {
     fi
 }
 
+# set broker.rack from the topology label of the node
+kafka_setup_rack() {
+    process_role=$1
+    [[ -n "${RACK_TOPOLOGY_KEY}" ]] || return 0
+    [[ "${process_role}" == "broker" || "${process_role}" == "server" ]] || return 0
+    [[ -n "${OPERATOR_APIS_ADDR}" ]] || die "kafka_setup_rack: OPERATOR_APIS_ADDR is empty"
+
+    rack=$(operator_apis "nodes/${NODE_NAME}/label" -G --data-urlencode "key=${RACK_TOPOLOGY_KEY}")
+    if [[ $? -ne 0 || -z "${rack}" ]]; then
+        die "kafka_setup_rack: failed to retrieve the label ${RACK_TOPOLOGY_KEY} of node ${NODE_NAME}"
+    fi
+    echo "kafka_setup_rack: rack=${rack}"
+    add_or_setup_value "broker.rack" "${rack}" "${kafka_dir}/config/kraft/${process_role}.properties"
+}
+
+# add the TLS listener routed by the gateway, the keystore is the PEM of the key and the certificate of the secret
+kafka_setup_external_listener() {
+    process_role=$1
+    [[ -n "${KAFKA_EXTERNAL_LISTENER_PORT}" ]] || return 0
+    [[ "${process_role}" == "broker" || "${process_role}" == "server" ]] || return 0
+    [[ -n "${KAFKA_EXTERNAL_TLS_DIR}" ]] || die "kafka_setup_external_listener: KAFKA_EXTERNAL_TLS_DIR is empty"
+
+    file_name="${kafka_dir}/config/kraft/${process_role}.properties"
+    keystore="${kafka_dir}/config/external-keystore.pem"
+    cat "${KAFKA_EXTERNAL_TLS_DIR}/tls.key" "${KAFKA_EXTERNAL_TLS_DIR}/tls.crt" > "${keystore}" \
+        || die "kafka_setup_external_listener: failed to write the keystore ${keystore}"
+    listeners=$(grep "^listeners=" "${file_name}" | cut -d= -f2-)
+    setup_value "listeners" "${listeners},EXTERNAL://0.0.0.0:${KAFKA_EXTERNAL_LISTENER_PORT}" "${file_name}"
+    add_or_setup_value "listener.security.protocol.map" "CONTROLLER:PLAINTEXT,PLAINTEXT:PLAINTEXT,EXTERNAL:SSL" "${file_name}"
+    add_or_setup_value "inter.broker.listener.name" "PLAINTEXT" "${file_name}"
+    add_or_setup_value "listener.name.external.ssl.keystore.type" "PEM" "${file_name}"
+    add_or_setup_value "listener.name.external.ssl.keystore.location" "${keystore}" "${file_name}"
+}
+
+# remove the quorum state stored by KRaft when its voters differ from the static controller.quorum.voters, the node of
+# Kafka 3.x refuses to start with the voters changed by the controller scaling otherwise
+kafka_reset_quorum_state() {
+    process_role=$1
+    quorum_voters=$2
+    quorum_state="${data_path}/kraft-${process_role}-logs/__cluster_metadata-0/quorum-state"
+    [[ -f "${quorum_state}" ]] || return 0
+
+    stored_voters=$(grep -o '"voterId": *[0-9]*' "${quorum_state}" | grep -o '[0-9]*$' | sort -n | tr '\n' ',')
+    configured_voters=$(echo "${quorum_voters}" | tr ',' '\n' | cut -d@ -f1 | sort -n | tr '\n' ',')
+    if [[ "${stored_voters}" != "${configured_voters}" ]]; then
+        echo "kafka_reset_quorum_state: the stored voters ${stored_voters} differ from the configured voters ${configured_voters}, remove ${quorum_state}"
+        rm -f "${quorum_state}" || die "kafka_reset_quorum_state: failed to remove ${quorum_state}"
+    fi
+}
+
 configure_from_environment_variables() {
     file_name=$1
     # List of special cases to apply to the variables
@@ -280,7 +340,9 @@ kafka_up() {
       setup_value "log.dirs" "${data_path}/kraft-${role}-logs" "${kafka_dir}/config/kraft/${role}.properties"
       setup_value "s3.wal.path" "0@file://${data_path}/wal?capacity=2147483648" "${kafka_dir}/config/kraft/${role}.properties"
       # turn on auto_balancer
-      turn_on_auto_balancer "${role}" "${kafka_dir}/config/kraft/${role}.properties"
+      if [[ "${AUTO_BALANCER_ENABLE:-true}" == "true" ]]; then
+          turn_on_auto_balancer "${role}" "${kafka_dir}/config/kraft/${role}.properties"
+      fi
   done
 
   if [[ -n "${KAFKA_HEAP_OPTS}" ]]; then
@@ -295,21 +357,31 @@ kafka_up() {
   export KAFKA_HEAP_OPTS="${kafka_heap_opts}"
 
   # add this node's info to run.info
-  setup_value "node.id" "${node_id}" "${run_info_file}"
-  setup_value "role" "${process_role}" "${run_info_file}"
-  setup_value "kafka.base.path" "${kafka_dir}" "${run_info_file}"
-  setup_value "kafka.data.path" "${data_path}" "${run_info_file}"
+  touch "${run_info_file}"
+  add_or_setup_value "node.id" "${node_id}" "${run_info_file}"
+  add_or_setup_value "role" "${process_role}" "${run_info_file}"
+  add_or_setup_value "kafka.base.path" "${kafka_dir}" "${run_info_file}"
+  add_or_setup_value "kafka.data.path" "${data_path}" "${run_info_file}"
 
   # change ip settings here
   kafka_monitor_ip
   echo "kafka_up: ip settings changed"
 
+  # set rack here
+  kafka_setup_rack "${process_role}"
+
+  # add the external listener here
+  kafka_setup_external_listener "${process_role}"
+
   # override settings from env
   configure_from_environment_variables "${kafka_dir}/config/kraft/${process_role}.properties"
 
   # Disable the default console logger in favour of KafkaAppender (which provides the exact output)
   echo "log4j.appender.stdout.Threshold=OFF" >> "${kafka_dir}/config/log4j.properties"
 
+  # the voters are changed by the controller scaling
+  kafka_reset_quorum_state "${process_role}" "${quorum_voters}"
+
   # format the data path
   must_do -v "${kafka_dir}/bin/kafka-storage.sh format -g -t ${cluster_id} -c ${kafka_dir}/config/kraft/${process_role}.properties"
 
@@ -0,0 +1,63 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaults
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// runResetQuorumState runs the kafka_reset_quorum_state of up.sh with the data path and the configured voters.
+func runResetQuorumState(t *testing.T, dataPath, voters string) {
+	t.Helper()
+	bash, err := exec.LookPath("bash")
+	if err != nil {
+		t.Skip("bash is not installed")
+	}
+	script := `eval "$(sed -n -e '/^die()/,/^}/p' -e '/^kafka_reset_quorum_state()/,/^}/p' up.sh)"; data_path="$1"; kafka_reset_quorum_state controller "$2"`
+	if out, err := exec.Command(bash, "-c", script, "bash", dataPath, voters).CombinedOutput(); err != nil {
+		t.Fatalf("kafka_reset_quorum_state: %v: %s", err, out)
+	}
+}
+
+func TestResetQuorumState(t *testing.T) {
+	dataPath := t.TempDir()
+	dir := filepath.Join(dataPath, "kraft-controller-logs", "__cluster_metadata-0")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	quorumState := filepath.Join(dir, "quorum-state")
+	state := `{"clusterId":"","leaderId":0,"leaderEpoch":3,"votedId":-1,"appliedOffset":0,"currentVoters":[{"voterId":0},{"voterId":1},{"voterId":2}],"data_version":0}`
+	if err := os.WriteFile(quorumState, []byte(state), 0644); err != nil {
+		t.Fatal(err)
+	}
+	voters := "0@automq-controller-0.default.svc:9093,1@automq-controller-1.default.svc:9093,2@automq-controller-2.default.svc:9093"
+	runResetQuorumState(t, dataPath, voters)
+	if _, err := os.Stat(quorumState); err != nil {
+		t.Errorf("the quorum state with the configured voters is removed: %v", err)
+	}
+
+	runResetQuorumState(t, dataPath, voters+",3@automq-controller-3.default.svc:9093,4@automq-controller-4.default.svc:9093")
+	if _, err := os.Stat(quorumState); !os.IsNotExist(err) {
+		t.Errorf("the quorum state with the old voters is kept: %v", err)
+	}
+
+	// the node without the quorum state starts as it is
+	runResetQuorumState(t, dataPath, voters)
+}
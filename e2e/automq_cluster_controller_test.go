@@ -25,9 +25,10 @@ import (
 	"github.com/cuisongliu/automq-operator/internal/controller"
 	v2 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	infrav1beta1 "github.com/cuisongliu/automq-operator/api/v1beta1"
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
@@ -46,7 +47,7 @@ var _ = Describe("automq_controller", func() {
 				Namespace: namespaceName,
 			},
 		}
-		automq := &infrav1beta1.AutoMQ{}
+		automq := &infrav1.AutoMQ{}
 		automq.Name = "automq-s1"
 		automq.Namespace = namespaceName
 		automq.Spec.ClusterID = "rZdE0DjZSrqy96PXrMUZVw"
@@ -68,8 +69,8 @@ var _ = Describe("automq_controller", func() {
 				// apply on the cluster the manifest under config/samples
 				automq.Spec.S3.Endpoint = fmt.Sprintf("http://%s:9000", ip)
 				automq.Spec.S3.Bucket = "ko3"
-				automq.Spec.S3.AccessKeyID = "admin"
-				automq.Spec.S3.SecretAccessKey = "minio123"
+				automq.Spec.S3.Credentials.AccessKeyID = "admin"
+				automq.Spec.S3.Credentials.SecretAccessKey = "minio123"
 				automq.Spec.S3.Region = "us-east-1"
 				automq.Spec.S3.EnablePathStyle = true
 				automq.Spec.Controller.Replicas = 1
@@ -82,10 +83,12 @@ var _ = Describe("automq_controller", func() {
 		It("should successfully reconcile the resource", func() {
 			By("Reconciling the created resource")
 			controllerReconciler := &controller.AutoMQReconciler{
-				Client:    k8sClient,
-				Scheme:    k8sClient.Scheme(),
-				Finalizer: "apps.cuisongliu.com/automq.finalizer",
-				MountTZ:   true,
+				Client:      k8sClient,
+				Scheme:      k8sClient.Scheme(),
+				Recorder:    record.NewFakeRecorder(100),
+				Finalizer:   "apps.cuisongliu.com/automq.finalizer",
+				MountTZ:     true,
+				APIsAddress: fmt.Sprintf("http://%s:9090", os.Getenv("OPERATOR_APIS_IP")),
 			}
 			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
 				NamespacedName: client.ObjectKeyFromObject(automq),
@@ -160,7 +163,7 @@ var _ = Describe("automq_controller", func() {
 				if err != nil {
 					return err
 				}
-				if automq.Status.Phase != infrav1beta1.AutoMQReady {
+				if automq.Status.Phase != infrav1.AutoMQReady {
 					return fmt.Errorf("expected automq phase to be 'Ready', got '%s'", automq.Status.Phase)
 				}
 				if automq.Status.ControllerReplicas != automq.Spec.Controller.Replicas {
@@ -194,7 +197,7 @@ var _ = Describe("automq_controller", func() {
 		})
 		It("clean automq", func() {
 			By("removing the custom resource for the automq")
-			found := &infrav1beta1.AutoMQ{}
+			found := &infrav1.AutoMQ{}
 			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(automq), found)
 			Expect(err).To(Not(HaveOccurred()))
 
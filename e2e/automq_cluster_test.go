@@ -39,7 +39,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
-	infrav1beta1 "github.com/cuisongliu/automq-operator/api/v1beta1"
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -190,7 +190,7 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 	Expect(cfg).NotTo(BeNil())
 
-	err = infrav1beta1.AddToScheme(scheme.Scheme)
+	err = infrav1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
 	err = clientgoscheme.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
@@ -210,7 +210,9 @@ var _ = BeforeSuite(func() {
 	Expect(err).To(Not(HaveOccurred()))
 
 	go func() {
-		controller.APIRegistry(context.Background(), k8sClient)
+		defer GinkgoRecover()
+		apiServer := &controller.APIServer{Client: k8sClient, APIReader: k8sClient, BindAddress: ":9090"}
+		Expect(apiServer.Start(context.Background())).To(Succeed())
 	}()
 	err = os.Setenv("NAMESPACE_NAME", "default")
 	Expect(err).To(Not(HaveOccurred()))
@@ -0,0 +1,137 @@
+/*
+Copyright 2024 cuisongliu.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	"github.com/cuisongliu/automq-operator/internal/controller"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v2 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("automq_controller", func() {
+	Context("automq_controller restricted tests", func() {
+		ctx := context.Background()
+		namespaceName := "automq-restricted"
+		namespace := &v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespaceName,
+				Labels: map[string]string{
+					"pod-security.kubernetes.io/enforce":         "restricted",
+					"pod-security.kubernetes.io/enforce-version": "latest",
+				},
+			},
+		}
+		automq := &infrav1.AutoMQ{}
+		automq.Name = "automq-r1"
+		automq.Namespace = namespaceName
+		automq.Spec.ClusterID = "rZdE0DjZSrqy96PXrMUZVw"
+		It("create restricted namespace", func() {
+			By("Creating the Namespace enforcing the restricted pod security standard")
+			err := k8sClient.Create(ctx, namespace)
+			Expect(err).To(Not(HaveOccurred()))
+		})
+		It("create restricted cr", func() {
+			By("get minio ip and port")
+			minioService := &v1.Service{}
+			err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "minio", Name: "minio"}, minioService)
+			Expect(err).To(Not(HaveOccurred()))
+			ip := minioService.Spec.ClusterIP
+			By("creating the restricted custom resource for the automq")
+			err = k8sClient.Get(ctx, client.ObjectKeyFromObject(automq), automq)
+			if err != nil && errors.IsNotFound(err) {
+				automq.Spec.S3.Endpoint = fmt.Sprintf("http://%s:9000", ip)
+				automq.Spec.S3.Bucket = "ko3-restricted"
+				automq.Spec.S3.Credentials.AccessKeyID = "admin"
+				automq.Spec.S3.Credentials.SecretAccessKey = "minio123"
+				automq.Spec.S3.Region = "us-east-1"
+				automq.Spec.S3.EnablePathStyle = true
+				automq.Spec.Controller.Replicas = 1
+				automq.Spec.Broker.Replicas = 1
+				automq.Spec.NodePort = 32019
+				automq.Spec.Sysctl.Enable = ptr.To(false)
+				automq.Spec.Security.Restricted = true
+				err = k8sClient.Create(ctx, automq)
+				Expect(err).To(Not(HaveOccurred()))
+			}
+		})
+		It("should successfully reconcile the restricted resource", func() {
+			By("Reconciling the created resource")
+			controllerReconciler := &controller.AutoMQReconciler{
+				Client:      k8sClient,
+				Scheme:      k8sClient.Scheme(),
+				Recorder:    record.NewFakeRecorder(100),
+				Finalizer:   "apps.cuisongliu.com/automq.finalizer",
+				MountTZ:     true,
+				APIsAddress: fmt.Sprintf("http://%s:9090", os.Getenv("OPERATOR_APIS_IP")),
+			}
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(automq),
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("check pods pass the restricted profile", func() {
+			deployment := &v2.DeploymentList{}
+			labelSelector := labels.Set(map[string]string{"app.kubernetes.io/owner-by": "automq", "app.kubernetes.io/instance": automq.Name}).AsSelector()
+			err := k8sClient.List(ctx, deployment, &client.ListOptions{Namespace: automq.Namespace, LabelSelector: labelSelector})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(deployment.Items).To(HaveLen(2))
+			for _, deploy := range deployment.Items {
+				By(fmt.Sprintf("admitting the pod of deployment %s", deploy.Name))
+				pod := &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        deploy.Name + "-restricted",
+						Namespace:   deploy.Namespace,
+						Labels:      deploy.Spec.Template.Labels,
+						Annotations: deploy.Spec.Template.Annotations,
+					},
+					Spec: deploy.Spec.Template.Spec,
+				}
+				// the PodSecurity admission plugin rejects the pod if it violates the restricted profile
+				err = k8sClient.Create(ctx, pod, client.DryRunAll)
+				Expect(err).To(Not(HaveOccurred()))
+			}
+		})
+		It("clean restricted automq", func() {
+			By("removing the restricted custom resource for the automq")
+			found := &infrav1.AutoMQ{}
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(automq), found)
+			Expect(err).To(Not(HaveOccurred()))
+
+			Eventually(func() error {
+				return k8sClient.Delete(context.TODO(), found)
+			}, 2*time.Minute, time.Second).Should(Succeed())
+
+			By("Deleting the Namespace to perform the tests")
+			_ = k8sClient.Delete(ctx, namespace)
+		})
+	})
+})
@@ -33,6 +33,9 @@ func genBinData() {
 			{
 				Path: "defaults/up.sh",
 			},
+			{
+				Path: "defaults/drain.sh",
+			},
 		},
 		Package:    "defaults",
 		NoCompress: true,
@@ -0,0 +1,27 @@
+#!/bin/bash
+# Render the CRD of config/crd/bases as the chart template, the conversion webhook of the CRD refers to the webhook
+# service of the release, so the CRD can not be installed from the crds directory of the chart.
+set -e
+CRD=config/crd/bases/infra.cuisongliu.github.com_automqs.yaml
+OUT=deploy/charts/automq-operator/templates/crds.yaml
+{
+  echo "# Code generated by hack/chart-crds.sh. DO NOT EDIT."
+  sed -e '/^  annotations:$/a\
+    helm.sh/resource-policy: keep\
+    {{- if .Values.webhook.enabled }}\
+    cert-manager.io/inject-ca-from: {{ .Release.Namespace }}/{{ include "automq-operator.fullname" . }}\
+    {{- end }}' \
+    -e '/^spec:$/a\
+  {{- if .Values.webhook.enabled }}\
+  conversion:\
+    strategy: Webhook\
+    webhook:\
+      clientConfig:\
+        service:\
+          name: {{ include "automq-operator.fullname" . }}-webhook\
+          namespace: {{ .Release.Namespace }}\
+          path: /convert\
+      conversionReviewVersions:\
+        - v1\
+  {{- end }}' "${CRD}"
+} >"${OUT}"
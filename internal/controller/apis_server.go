@@ -0,0 +1,142 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const apisShutdownTimeout = 10 * time.Second
+
+var (
+	apisRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "automq_operator_apis_requests_total",
+		Help: "Total number of the requests of the operator APIs by the route, method and status code.",
+	}, []string{"path", "method", "code"})
+	apisRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "automq_operator_apis_request_duration_seconds",
+		Help:    "Latency of the requests of the operator APIs by the route and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"path", "method"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(apisRequests, apisRequestDuration)
+}
+
+// APIServer serves the operator APIs to the AutoMQ pods. It is added to the manager without the leader election,
+// so every replica behind the APIs service answers the requests.
+type APIServer struct {
+	Client client.Client
+	// APIReader reads the token secrets without caching all the secrets of the cluster
+	APIReader client.Reader
+	// BindAddress is the address the APIs bind to, e.g. ":9090"
+	BindAddress string
+	// CertDir is the directory of tls.crt and tls.key, the APIs are served over HTTP when it is empty
+	CertDir string
+
+	serving atomic.Bool
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (s *APIServer) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves the APIs until the context is canceled, the in-flight requests are finished before it returns.
+func (s *APIServer) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("apis")
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+	router.Use(gin.Recovery(), apisMetrics)
+	registerAPIs(router, s.Client, s.APIReader)
+
+	ln, err := net.Listen("tcp", s.BindAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.BindAddress, err)
+	}
+	if s.CertDir != "" {
+		watcher, err := certwatcher.New(filepath.Join(s.CertDir, "tls.crt"), filepath.Join(s.CertDir, "tls.key"))
+		if err != nil {
+			_ = ln.Close()
+			return fmt.Errorf("failed to load the certificate of the apis: %w", err)
+		}
+		go func() {
+			if err := watcher.Start(ctx); err != nil {
+				log.Error(err, "failed to watch the certificate of the apis")
+			}
+		}()
+		ln = tls.NewListener(ln, &tls.Config{GetCertificate: watcher.GetCertificate, MinVersion: tls.VersionTLS12})
+	}
+	srv := &http.Server{Handler: router, ReadHeaderTimeout: 10 * time.Second}
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Info("serving the operator apis", "address", ln.Addr().String(), "tls", s.CertDir != "")
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+	}()
+	s.serving.Store(true)
+	defer s.serving.Store(false)
+	select {
+	case err = <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+	s.serving.Store(false)
+	log.Info("shutting down the operator apis")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), apisShutdownTimeout)
+	defer cancel()
+	return srv.Shutdown(shutdownCtx)
+}
+
+// ReadyzCheck reports the APIs not ready before they are serving and while they are shutting down.
+func (s *APIServer) ReadyzCheck(_ *http.Request) error {
+	if !s.serving.Load() {
+		return errors.New("the operator apis are not serving")
+	}
+	return nil
+}
+
+// apisMetrics records the requests by the route template, the unmatched paths are recorded as one route.
+func apisMetrics(c *gin.Context) {
+	start := time.Now()
+	c.Next()
+	path := c.FullPath()
+	if path == "" {
+		path = "unmatched"
+	}
+	apisRequests.WithLabelValues(path, c.Request.Method, strconv.Itoa(c.Writer.Status())).Inc()
+	apisRequestDuration.WithLabelValues(path, c.Request.Method).Observe(time.Since(start).Seconds())
+}
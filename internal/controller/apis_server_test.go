@@ -0,0 +1,94 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAPIServerShutdown(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	_ = ln.Close()
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	s := &APIServer{Client: k8sClient, APIReader: k8sClient, BindAddress: addr}
+	if s.NeedLeaderElection() {
+		t.Error("NeedLeaderElection() = true, want false")
+	}
+	if err = s.ReadyzCheck(nil); err == nil {
+		t.Error("ReadyzCheck() before Start = nil, want error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.Start(ctx) }()
+	if err = waitFor(func() bool { return s.ReadyzCheck(nil) == nil }); err != nil {
+		t.Fatal("the apis are not ready")
+	}
+	resp, err := http.Get("http://" + addr + "/api/v1/namespaces/default/automqs/automq/nodes/node-1/address")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("GET without the token = %d, want %d", resp.StatusCode, http.StatusUnauthorized)
+	}
+	if got := testutil.ToFloat64(apisRequests.WithLabelValues("/api/v1/namespaces/:namespace/automqs/:name/nodes/:node/address", http.MethodGet, "401")); got < 1 {
+		t.Errorf("apis requests = %v, want at least 1", got)
+	}
+
+	cancel()
+	select {
+	case err = <-done:
+		if err != nil {
+			t.Errorf("Start() = %v, want nil", err)
+		}
+	case <-time.After(apisShutdownTimeout):
+		t.Fatal("the apis are not shut down")
+	}
+	if err = s.ReadyzCheck(nil); err == nil {
+		t.Error("ReadyzCheck() after shutdown = nil, want error")
+	}
+}
+
+func waitFor(cond func() bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for !cond() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	return nil
+}
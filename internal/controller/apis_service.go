@@ -0,0 +1,124 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get
+//+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update
+//+kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get
+//+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get
+
+// APIsService creates the Service of the operator APIs in the namespace of the operator. The Service selects the
+// pods of the operator Deployment and is owned by it, so the brokers reach the APIs by a stable DNS name wherever
+// the operator pods run.
+type APIsService struct {
+	Client client.Client
+	// Port is the port of the APIs served by the operator pods
+	Port int32
+
+	deploy *appsv1.Deployment
+}
+
+// NewAPIsService discovers the Deployment running the operator pod by the owner references of the pod and its
+// ReplicaSet.
+func NewAPIsService(ctx context.Context, reader client.Reader, namespace, podName string, port int32) (*APIsService, error) {
+	pod := &v1.Pod{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: podName}, pod); err != nil {
+		return nil, fmt.Errorf("failed to get the operator pod %s/%s: %w", namespace, podName, err)
+	}
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "ReplicaSet" {
+		return nil, fmt.Errorf("the operator pod %s/%s is not controlled by a ReplicaSet", namespace, podName)
+	}
+	rs := &appsv1.ReplicaSet{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: owner.Name}, rs); err != nil {
+		return nil, fmt.Errorf("failed to get the ReplicaSet of the operator pod %s/%s: %w", namespace, podName, err)
+	}
+	owner = metav1.GetControllerOf(rs)
+	if owner == nil || owner.Kind != "Deployment" {
+		return nil, fmt.Errorf("the ReplicaSet %s/%s is not controlled by a Deployment", namespace, rs.Name)
+	}
+	deploy := &appsv1.Deployment{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: owner.Name}, deploy); err != nil {
+		return nil, fmt.Errorf("failed to get the Deployment of the operator pod %s/%s: %w", namespace, podName, err)
+	}
+	if deploy.Spec.Selector == nil || len(deploy.Spec.Selector.MatchLabels) == 0 {
+		return nil, fmt.Errorf("the Deployment %s/%s has no matchLabels selector", namespace, deploy.Name)
+	}
+	return &APIsService{Port: port, deploy: deploy}, nil
+}
+
+// Name returns the name of the Service, it is named after the operator Deployment.
+func (s *APIsService) Name() string {
+	return s.deploy.Name + "-apis"
+}
+
+// Host returns the DNS name of the Service injected into the broker pods.
+func (s *APIsService) Host() string {
+	return fmt.Sprintf("%s.%s.svc", s.Name(), s.deploy.Namespace)
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, every replica keeps the Service in sync so the
+// brokers are served before the leader is elected.
+func (s *APIsService) NeedLeaderElection() bool {
+	return false
+}
+
+// Start creates or updates the Service once the caches are synced.
+func (s *APIsService) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("apis")
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		svc := &v1.Service{}
+		svc.Name = s.Name()
+		svc.Namespace = s.deploy.Namespace
+		change, err := controllerutil.CreateOrUpdate(ctx, s.Client, svc, func() error {
+			if svc.Labels == nil {
+				svc.Labels = map[string]string{}
+			}
+			svc.Labels["app.kubernetes.io/managed-by"] = s.deploy.Name
+			svc.Labels["app.kubernetes.io/component"] = "apis"
+			svc.Spec.Selector = s.deploy.Spec.Selector.MatchLabels
+			svc.Spec.Ports = []v1.ServicePort{
+				{
+					Name:       "apis",
+					Port:       s.Port,
+					Protocol:   v1.ProtocolTCP,
+					TargetPort: intstr.FromInt32(s.Port),
+				},
+			}
+			return controllerutil.SetControllerReference(s.deploy, svc, s.Client.Scheme())
+		})
+		if err != nil {
+			return err
+		}
+		log.Info("create or update the service of the operator apis", "service", client.ObjectKeyFromObject(svc), "OperationResult", change)
+		return nil
+	})
+}
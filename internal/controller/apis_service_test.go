@@ -0,0 +1,77 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAPIsService(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	selector := map[string]string{"app.kubernetes.io/name": "automq-operator"}
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "automq-operator", Namespace: "automq-system", UID: "deploy-uid"},
+		Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: selector}},
+	}
+	rs := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+		Name: "automq-operator-7d9f", Namespace: "automq-system",
+		OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: deploy.Name, UID: deploy.UID, Controller: ptr.To(true)}},
+	}}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name: "automq-operator-7d9f-x2x4z", Namespace: "automq-system",
+		OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: rs.Name, UID: "rs-uid", Controller: ptr.To(true)}},
+	}}
+	orphan := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "orphan", Namespace: "automq-system"}}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deploy, rs, pod, orphan).Build()
+
+	if _, err := NewAPIsService(ctx, k8sClient, "automq-system", orphan.Name, 9090); err == nil {
+		t.Error("NewAPIsService() of the pod without the Deployment = nil, want error")
+	}
+	s, err := NewAPIsService(ctx, k8sClient, "automq-system", pod.Name, 9090)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Host() != "automq-operator-apis.automq-system.svc" {
+		t.Errorf("Host() = %s, want automq-operator-apis.automq-system.svc", s.Host())
+	}
+	s.Client = k8sClient
+	if err = s.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	svc := &v1.Service{}
+	if err = k8sClient.Get(ctx, client.ObjectKey{Namespace: "automq-system", Name: s.Name()}, svc); err != nil {
+		t.Fatal(err)
+	}
+	if svc.Spec.Selector["app.kubernetes.io/name"] != "automq-operator" || len(svc.Spec.Ports) != 1 || svc.Spec.Ports[0].Port != 9090 {
+		t.Errorf("the service of the apis = %+v", svc.Spec)
+	}
+	if owner := metav1.GetControllerOf(svc); owner == nil || owner.UID != deploy.UID {
+		t.Errorf("the owner of the service = %v, want the operator Deployment", owner)
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// apisTokenKey is the key of the token in the secret of the operator APIs
+	apisTokenKey = "token"
+	// apisCAKey is the key of the CA of the operator APIs served over TLS
+	apisCAKey = "ca.crt"
+)
+
+func apisTokenSecretName(obj *infrav1.AutoMQ) string {
+	return obj.Name + "-apis-token"
+}
+
+func newAPIsToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// syncAPIsToken creates the secret of the token authenticating the AutoMQ pods to the operator APIs and the CA
+// verifying the APIs, the token is generated once and kept until the AutoMQ is deleted.
+func (r *AutoMQReconciler) syncAPIsToken(ctx context.Context, obj *infrav1.AutoMQ) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	conditionType := "SyncAPIsTokenReady"
+	// the CA is read on every reconcile, it changes when the certificate of the operator is renewed
+	var ca []byte
+	var err error
+	if r.APIsCAFile != "" {
+		if ca, err = os.ReadFile(r.APIsCAFile); err != nil {
+			log.Error(err, "Failed to read the CA of the operator apis", "file", r.APIsCAFile)
+			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+				Type:               conditionType,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: obj.Generation,
+				Reason:             "APIsTokenReconcilingInit",
+				Message:            fmt.Sprintf("Failed to read the CA of the operator apis (%s): (%s)", r.APIsCAFile, err),
+			})
+			return ctrl.Result{}, err
+		}
+	}
+	if err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret := &v1.Secret{}
+		secret.Name = apisTokenSecretName(obj)
+		secret.Namespace = obj.Namespace
+		change, e := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+			secret.Labels = getAutoMQLabelMap(obj.GetName(), "")
+			if secret.Data == nil {
+				secret.Data = map[string][]byte{}
+			}
+			if len(ca) != 0 {
+				secret.Data[apisCAKey] = ca
+			} else {
+				delete(secret.Data, apisCAKey)
+			}
+			if len(secret.Data[apisTokenKey]) != 0 {
+				return nil
+			}
+			token, err := newAPIsToken()
+			if err != nil {
+				return err
+			}
+			secret.Data[apisTokenKey] = []byte(token)
+			return nil
+		})
+		if e != nil {
+			return e
+		}
+		log.V(1).Info("create or update apis token secret by AutoMQ", "OperationResult", change)
+		return nil
+	}); err != nil {
+		log.Error(err, "Failed to create apis token secret for the custom resource", "name", obj.Name, "namespace", obj.Namespace)
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.Generation,
+			Reason:             "APIsTokenReconcilingCreate",
+			Message:            fmt.Sprintf("Failed to create apis token secret for the custom resource (%s): (%s)", obj.Name, err),
+		})
+		return ctrl.Result{}, err
+	}
+	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.Generation,
+		Reason:             "APIsTokenReconciling",
+		Message:            fmt.Sprintf("APIs token secret for the custom resource (%s) has been created", obj.Name),
+	})
+	return ctrl.Result{}, nil
+}
+
+// apisEnvs returns the environment variables of the broker calling the operator APIs, the token and the CA are
+// referenced from the secret so they are not copied into the deployment.
+func (r *AutoMQReconciler) apisEnvs(obj *infrav1.AutoMQ) []v1.EnvVar {
+	envs := []v1.EnvVar{
+		{
+			Name:  "OPERATOR_APIS_ADDR",
+			Value: r.APIsAddress,
+		},
+		{
+			Name:  "AUTOMQ_NAME",
+			Value: obj.Name,
+		},
+		{
+			Name: "OPERATOR_APIS_TOKEN",
+			ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: apisTokenSecretName(obj)},
+				Key:                  apisTokenKey,
+			}},
+		},
+	}
+	if r.APIsCAFile != "" {
+		envs = append(envs, v1.EnvVar{
+			Name: "OPERATOR_APIS_CA",
+			ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: apisTokenSecretName(obj)},
+				Key:                  apisCAKey,
+			}},
+		})
+	}
+	return envs
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strconv"
+	"strings"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// defaultAutoBalancerNetworkCapacity is the network capacity of a broker in KB/s.
+	defaultAutoBalancerNetworkCapacity   int64 = 5120
+	defaultAutoBalancerReportingInterval int32 = 5000
+	defaultAutoBalancerExcludeTopics           = "__consumer_offsets"
+
+	ingressBandwidthAnnotation = "kubernetes.io/ingress-bandwidth"
+	egressBandwidthAnnotation  = "kubernetes.io/egress-bandwidth"
+)
+
+// autoBalancerEnvs returns the environment variables rendering the auto balancer configuration for the process role,
+// the KAFKA_CFG_ variables are written into the properties by up.sh.
+func autoBalancerEnvs(obj *infrav1.AutoMQ, processRole string) []v1.EnvVar {
+	ab := obj.Spec.AutoBalancer
+	enabled := strconv.FormatBool(ab.IsEnabled())
+	envs := []v1.EnvVar{
+		{
+			Name:  "AUTO_BALANCER_ENABLE",
+			Value: enabled,
+		},
+	}
+	if processRole == controllerRole || processRole == serverRole {
+		envs = append(envs, v1.EnvVar{
+			Name:  "KAFKA_CFG_AUTOBALANCER_CONTROLLER_ENABLE",
+			Value: enabled,
+		})
+		if ab.IsEnabled() {
+			excludeTopics := defaultAutoBalancerExcludeTopics
+			if len(ab.ExcludeTopics) > 0 {
+				excludeTopics = strings.Join(ab.ExcludeTopics, ",")
+			}
+			envs = append(envs, v1.EnvVar{
+				Name:  "KAFKA_CFG_AUTOBALANCER_CONTROLLER_EXCLUDE_TOPICS",
+				Value: excludeTopics,
+			})
+			if len(ab.Goals) > 0 {
+				envs = append(envs, v1.EnvVar{
+					Name:  "KAFKA_CFG_AUTOBALANCER_CONTROLLER_GOALS",
+					Value: strings.Join(ab.Goals, ","),
+				})
+			}
+		}
+	}
+	if (processRole == brokerRole || processRole == serverRole) && ab.IsEnabled() {
+		var annotations map[string]string
+		if obj.Spec.Broker.PodTemplate != nil {
+			annotations = obj.Spec.Broker.PodTemplate.Annotations
+		}
+		interval := defaultAutoBalancerReportingInterval
+		if ab.ReportingIntervalMs != nil {
+			interval = *ab.ReportingIntervalMs
+		}
+		envs = append(envs, []v1.EnvVar{
+			{
+				Name:  "KAFKA_CFG_AUTOBALANCER_REPORTER_NETWORK_IN_CAPACITY",
+				Value: strconv.FormatInt(networkCapacity(ab.NetworkInCapacity, annotations[ingressBandwidthAnnotation]), 10),
+			},
+			{
+				Name:  "KAFKA_CFG_AUTOBALANCER_REPORTER_NETWORK_OUT_CAPACITY",
+				Value: strconv.FormatInt(networkCapacity(ab.NetworkOutCapacity, annotations[egressBandwidthAnnotation]), 10),
+			},
+			{
+				Name:  "KAFKA_CFG_AUTOBALANCER_REPORTER_METRICS_REPORTING_INTERVAL_MS",
+				Value: strconv.Itoa(int(interval)),
+			},
+		}...)
+	}
+	return envs
+}
+
+// networkCapacity returns the network capacity in KB/s from the explicit bytes per second, or the bandwidth annotation
+// in bits per second used by the CNI bandwidth plugin.
+func networkCapacity(capacity *resource.Quantity, bandwidth string) int64 {
+	var bytes int64
+	if capacity != nil {
+		bytes = capacity.Value()
+	} else if q, err := resource.ParseQuantity(bandwidth); bandwidth != "" && err == nil {
+		bytes = q.Value() / 8
+	}
+	if kb := bytes / 1024; kb > 0 {
+		return kb
+	}
+	return defaultAutoBalancerNetworkCapacity
+}
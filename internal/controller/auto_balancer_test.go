@@ -0,0 +1,108 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+func envValue(envs []v1.EnvVar, name string) (string, bool) {
+	for _, env := range envs {
+		if env.Name == name {
+			return env.Value, true
+		}
+	}
+	return "", false
+}
+
+func TestAutoBalancerEnvs(t *testing.T) {
+	obj := &infrav1.AutoMQ{}
+	obj.Spec.AutoBalancer.Goals = []string{"kafka.autobalancer.goals.NetworkInUsageDistributionGoal"}
+	obj.Spec.AutoBalancer.NetworkInCapacity = ptr.To(resource.MustParse("100Mi"))
+	obj.Spec.Broker.PodTemplate = &infrav1.PodTemplate{
+		Annotations: map[string]string{egressBandwidthAnnotation: "80M"},
+	}
+
+	tests := []struct {
+		name        string
+		processRole string
+		want        map[string]string
+		absent      []string
+	}{
+		{
+			name:        "controller",
+			processRole: controllerRole,
+			want: map[string]string{
+				"AUTO_BALANCER_ENABLE":                             "true",
+				"KAFKA_CFG_AUTOBALANCER_CONTROLLER_ENABLE":         "true",
+				"KAFKA_CFG_AUTOBALANCER_CONTROLLER_GOALS":          "kafka.autobalancer.goals.NetworkInUsageDistributionGoal",
+				"KAFKA_CFG_AUTOBALANCER_CONTROLLER_EXCLUDE_TOPICS": "__consumer_offsets",
+			},
+			absent: []string{"KAFKA_CFG_AUTOBALANCER_REPORTER_NETWORK_IN_CAPACITY"},
+		},
+		{
+			name:        "broker",
+			processRole: brokerRole,
+			want: map[string]string{
+				"KAFKA_CFG_AUTOBALANCER_REPORTER_NETWORK_IN_CAPACITY":           "102400",
+				"KAFKA_CFG_AUTOBALANCER_REPORTER_NETWORK_OUT_CAPACITY":          "9765",
+				"KAFKA_CFG_AUTOBALANCER_REPORTER_METRICS_REPORTING_INTERVAL_MS": "5000",
+			},
+			absent: []string{"KAFKA_CFG_AUTOBALANCER_CONTROLLER_ENABLE"},
+		},
+		{
+			name:        "server",
+			processRole: serverRole,
+			want: map[string]string{
+				"KAFKA_CFG_AUTOBALANCER_CONTROLLER_ENABLE":            "true",
+				"KAFKA_CFG_AUTOBALANCER_REPORTER_NETWORK_IN_CAPACITY": "102400",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envs := autoBalancerEnvs(obj, tt.processRole)
+			for name, want := range tt.want {
+				if got, _ := envValue(envs, name); got != want {
+					t.Errorf("%s = %q, want %q", name, got, want)
+				}
+			}
+			for _, name := range tt.absent {
+				if _, ok := envValue(envs, name); ok {
+					t.Errorf("%s is not expected", name)
+				}
+			}
+		})
+	}
+}
+
+func TestAutoBalancerEnvsDisabled(t *testing.T) {
+	obj := &infrav1.AutoMQ{}
+	obj.Spec.AutoBalancer.Enable = ptr.To(false)
+	envs := autoBalancerEnvs(obj, serverRole)
+	if got, _ := envValue(envs, "KAFKA_CFG_AUTOBALANCER_CONTROLLER_ENABLE"); got != "false" {
+		t.Errorf("KAFKA_CFG_AUTOBALANCER_CONTROLLER_ENABLE = %q, want false", got)
+	}
+	if _, ok := envValue(envs, "KAFKA_CFG_AUTOBALANCER_REPORTER_NETWORK_IN_CAPACITY"); ok {
+		t.Error("the reporter is not expected when the auto balancer is disabled")
+	}
+}
@@ -18,37 +18,271 @@ package controller
 
 import (
 	"context"
+	"crypto/subtle"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
 
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
 	"github.com/gin-gonic/gin"
 	v1 "k8s.io/api/core/v1"
-	ctrl "sigs.k8s.io/controller-runtime"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func APIRegistry(ctx context.Context, k8sClient client.Client) {
-	setupLog := ctrl.Log.WithName("setup")
-	setupLog.Info("cache sync success")
-	router := gin.Default()
-	router.GET("/api/v1/nodes/:name", func(c *gin.Context) {
-		name := c.Param("name")
-		node := &v1.Node{}
-		node.Name = name
-		if noe := k8sClient.Get(ctx, client.ObjectKeyFromObject(node), node); noe != nil {
-			c.JSON(500, gin.H{"message": noe.Error()})
+// defaultNodeAddressTypes is the node address preference of the AutoMQ without nodeAddressTypes
+var defaultNodeAddressTypes = []v1.NodeAddressType{v1.NodeInternalIP}
+
+// listenerSet is the listeners of the AutoMQ pod, the advertised listeners are empty for the controllers.
+type listenerSet struct {
+	Listeners           []string `json:"listeners"`
+	AdvertisedListeners []string `json:"advertisedListeners,omitempty"`
+}
+
+type automqAPIs struct {
+	client.Client
+	// APIReader reads the token secrets without caching all the secrets of the cluster
+	APIReader client.Reader
+}
+
+// registerAPIs registers the APIs serving the node addresses and the listeners to the AutoMQ pods, the requests
+// are authenticated by the token of the AutoMQ.
+func registerAPIs(router gin.IRouter, k8sClient client.Client, apiReader client.Reader) {
+	apis := &automqAPIs{Client: k8sClient, APIReader: apiReader}
+	group := router.Group("/api/v1/namespaces/:namespace/automqs/:name", apis.authenticate)
+	group.GET("/nodes/:node/address", apis.nodeAddress)
+	group.GET("/nodes/:node/label", apis.nodeLabel)
+	group.GET("/pods/:pod/listeners", apis.podListeners)
+	group.GET("/pods/:pod/advertised-listeners", apis.podAdvertisedListeners)
+}
+
+// authenticate compares the bearer token with the token secret of the AutoMQ, and keeps the AutoMQ in the context.
+func (a *automqAPIs) authenticate(c *gin.Context) {
+	ctx := c.Request.Context()
+	namespace, name := c.Param("namespace"), c.Param("name")
+	token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "bearer token is required"})
+		return
+	}
+	obj := &infrav1.AutoMQ{}
+	if err := a.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		// the missing AutoMQ is not distinguished from the wrong token
+		if apierrors.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "invalid token"})
 			return
 		}
-		nodeIP := ""
-		for _, addr := range node.Status.Addresses {
-			if addr.Type == v1.NodeInternalIP {
-				nodeIP = addr.Address
-				break
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	secret := &v1.Secret{}
+	if err := a.APIReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: apisTokenSecretName(obj)}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "invalid token"})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	expected := secret.Data[apisTokenKey]
+	if len(expected) == 0 || subtle.ConstantTimeCompare(expected, []byte(token)) != 1 {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"message": "invalid token"})
+		return
+	}
+	c.Set("automq", obj)
+	c.Next()
+}
+
+// automqNode returns the node running a pod of the AutoMQ, the other nodes are not exposed to the AutoMQ pods.
+func (a *automqAPIs) automqNode(ctx context.Context, obj *infrav1.AutoMQ, nodeName string) (*v1.Node, int, error) {
+	pods := &v1.PodList{}
+	if err := a.List(ctx, pods, client.InNamespace(obj.Namespace), client.MatchingLabels(getAutoMQLabelMap(obj.Name, ""))); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	found := false
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == nodeName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, http.StatusForbidden, fmt.Errorf("no pod of the automq %s runs on the node %s", obj.Name, nodeName)
+	}
+	node := &v1.Node{}
+	if err := a.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	return node, http.StatusOK, nil
+}
+
+func (a *automqAPIs) nodeAddress(c *gin.Context) {
+	obj := c.MustGet("automq").(*infrav1.AutoMQ)
+	node, code, err := a.automqNode(c.Request.Context(), obj, c.Param("node"))
+	if err != nil {
+		c.JSON(code, gin.H{"message": err.Error()})
+		return
+	}
+	address, ok := nodeAddressOf(node, nodeAddressTypes(obj))
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"message": fmt.Sprintf("node address of the types %v not found", nodeAddressTypes(obj))})
+		return
+	}
+	c.String(http.StatusOK, address)
+}
+
+func (a *automqAPIs) nodeLabel(c *gin.Context) {
+	obj := c.MustGet("automq").(*infrav1.AutoMQ)
+	key := c.Query("key")
+	if key == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"message": "label key is required"})
+		return
+	}
+	node, code, err := a.automqNode(c.Request.Context(), obj, c.Param("node"))
+	if err != nil {
+		c.JSON(code, gin.H{"message": err.Error()})
+		return
+	}
+	value, ok := node.Labels[key]
+	if !ok || value == "" {
+		c.JSON(http.StatusNotFound, gin.H{"message": "node label " + key + " not found"})
+		return
+	}
+	c.String(http.StatusOK, value)
+}
+
+func (a *automqAPIs) podListeners(c *gin.Context) {
+	listeners, code, err := a.listenersOf(c)
+	if err != nil {
+		c.JSON(code, gin.H{"message": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, listeners)
+}
+
+// podAdvertisedListeners returns the advertised.listeners of the broker for the start script.
+func (a *automqAPIs) podAdvertisedListeners(c *gin.Context) {
+	listeners, code, err := a.listenersOf(c)
+	if err != nil {
+		c.JSON(code, gin.H{"message": err.Error()})
+		return
+	}
+	if len(listeners.AdvertisedListeners) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"message": "the pod has no advertised listeners"})
+		return
+	}
+	c.String(http.StatusOK, strings.Join(listeners.AdvertisedListeners, ","))
+}
+
+func (a *automqAPIs) listenersOf(c *gin.Context) (*listenerSet, int, error) {
+	ctx := c.Request.Context()
+	obj := c.MustGet("automq").(*infrav1.AutoMQ)
+	pod := &v1.Pod{}
+	if err := a.Get(ctx, client.ObjectKey{Namespace: obj.Namespace, Name: c.Param("pod")}, pod); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+	if pod.Labels["app.kubernetes.io/instance"] != obj.Name {
+		return nil, http.StatusNotFound, fmt.Errorf("the pod %s does not belong to the automq %s", pod.Name, obj.Name)
+	}
+	if pod.Labels["app.kubernetes.io/role"] == controllerRole {
+		return &listenerSet{Listeners: []string{"CONTROLLER://0.0.0.0:9093"}}, http.StatusOK, nil
+	}
+	index, err := strconv.ParseInt(pod.Labels[autoMQIndexKey], 10, 32)
+	if err != nil {
+		return nil, http.StatusInternalServerError, fmt.Errorf("invalid index label of the pod %s: %w", pod.Name, err)
+	}
+	if pod.Spec.NodeName == "" {
+		return nil, http.StatusConflict, fmt.Errorf("the pod %s is not scheduled", pod.Name)
+	}
+	brokerIndex := int32(index)
+	svc := &v1.Service{}
+	if err = a.Get(ctx, client.ObjectKey{Namespace: obj.Namespace, Name: getAutoMQName(brokerRole, &brokerIndex)}, svc); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if obj.IsTLSRoute() {
+		return tlsRouteListeners(obj, svc, brokerIndex), http.StatusOK, nil
+	}
+	if obj.IsLoadBalancer() {
+		address, ok := loadBalancerAddress(svc, brokerHostname(obj, brokerIndex))
+		if !ok {
+			return nil, http.StatusConflict, fmt.Errorf("the ingress of the LoadBalancer service %s is not assigned", svc.Name)
+		}
+		return brokerListeners(obj, address, 9092), http.StatusOK, nil
+	}
+	// the broker on the host network is reached by the host port of the node
+	nodePort := int32(9092)
+	if !obj.Spec.Broker.HostNetwork {
+		nodePort = 0
+		for _, port := range svc.Spec.Ports {
+			if port.Name == brokerRole {
+				nodePort = port.NodePort
 			}
 		}
-		if nodeIP == "" {
-			c.JSON(500, gin.H{"message": "node ip not found"})
-			return
+		if nodePort == 0 {
+			return nil, http.StatusConflict, fmt.Errorf("the node port of the service %s is not allocated", svc.Name)
+		}
+	}
+	node := &v1.Node{}
+	if err = a.Get(ctx, client.ObjectKey{Name: pod.Spec.NodeName}, node); err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	address, ok := nodeAddressOf(node, nodeAddressTypes(obj))
+	if !ok {
+		return nil, http.StatusNotFound, fmt.Errorf("node address of the types %v not found", nodeAddressTypes(obj))
+	}
+	return brokerListeners(obj, address, nodePort), http.StatusOK, nil
+}
+
+// brokerListeners returns the listeners of the broker advertising the address with the node port, the host port or the
+// port of the LoadBalancer service.
+func brokerListeners(obj *infrav1.AutoMQ, address string, port int32) *listenerSet {
+	listeners := &listenerSet{
+		Listeners:           []string{"PLAINTEXT://0.0.0.0:9092"},
+		AdvertisedListeners: []string{"PLAINTEXT://" + net.JoinHostPort(address, strconv.Itoa(int(port)))},
+	}
+	if obj.IsCombined() {
+		listeners.Listeners = append(listeners.Listeners, "CONTROLLER://0.0.0.0:9093")
+	}
+	return listeners
+}
+
+// tlsRouteListeners returns the listeners of the broker routed by the TLSRoute, the clients in the cluster are
+// advertised the broker service and the clients out of the cluster the SNI hostname of the gateway.
+func tlsRouteListeners(obj *infrav1.AutoMQ, svc *v1.Service, index int32) *listenerSet {
+	external := strconv.Itoa(externalListenerPort)
+	listeners := &listenerSet{
+		Listeners: []string{"PLAINTEXT://0.0.0.0:9092", "EXTERNAL://0.0.0.0:" + external},
+		AdvertisedListeners: []string{
+			"PLAINTEXT://" + net.JoinHostPort(fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace), "9092"),
+			"EXTERNAL://" + net.JoinHostPort(brokerHostname(obj, index), strconv.Itoa(int(tlsRoutePort(obj)))),
+		},
+	}
+	if obj.IsCombined() {
+		listeners.Listeners = append(listeners.Listeners, "CONTROLLER://0.0.0.0:9093")
+	}
+	return listeners
+}
+
+func nodeAddressTypes(obj *infrav1.AutoMQ) []v1.NodeAddressType {
+	if len(obj.Spec.NodeAddressTypes) == 0 {
+		return defaultNodeAddressTypes
+	}
+	return obj.Spec.NodeAddressTypes
+}
+
+// nodeAddressOf returns the first address of the node by the preference of the address types.
+func nodeAddressOf(node *v1.Node, types []v1.NodeAddressType) (string, bool) {
+	for _, addressType := range types {
+		for _, addr := range node.Status.Addresses {
+			if addr.Type == addressType && addr.Address != "" {
+				return addr.Address, true
+			}
 		}
-		c.String(200, nodeIP)
-	})
-	router.Run(":9090")
+	}
+	return "", false
 }
@@ -0,0 +1,226 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	"github.com/gin-gonic/gin"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newAPIsRouter(t *testing.T, addressTypes ...v1.NodeAddressType) (*gin.Engine, client.Client) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+	obj := &infrav1.AutoMQ{ObjectMeta: metav1.ObjectMeta{Name: "automq", Namespace: "default"}}
+	obj.Spec.NodeAddressTypes = addressTypes
+	index := int32(0)
+	podLabels := getAutoMQLabelMap(obj.Name, brokerRole)
+	podLabels[autoMQIndexKey] = "0"
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		obj,
+		&v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: apisTokenSecretName(obj), Namespace: "default"},
+			Data:       map[string][]byte{apisTokenKey: []byte("token")},
+		},
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "automq-broker-0-abc", Namespace: "default", Labels: podLabels},
+			Spec:       v1.PodSpec{NodeName: "node-1"},
+		},
+		&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: getAutoMQName(brokerRole, &index), Namespace: "default"},
+			Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Name: brokerRole, Port: 9092, NodePort: 30001}}},
+		},
+		&v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"topology.kubernetes.io/zone": "zone-a"}},
+			Status: v1.NodeStatus{Addresses: []v1.NodeAddress{
+				{Type: v1.NodeInternalIP, Address: "10.0.0.1"},
+				{Type: v1.NodeExternalIP, Address: "1.2.3.4"},
+				{Type: v1.NodeHostName, Address: "node-1"},
+			}},
+		},
+		&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-2"}},
+	).WithStatusSubresource(&v1.Service{}).Build()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	registerAPIs(router, k8sClient, k8sClient)
+	return router, k8sClient
+}
+
+func serveAPIs(router *gin.Engine, path, token string) (int, string) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/namespaces/default/automqs/automq/"+path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w.Code, w.Body.String()
+}
+
+func TestAPIsAuthentication(t *testing.T) {
+	router, _ := newAPIsRouter(t)
+	for _, tc := range []struct {
+		name  string
+		path  string
+		token string
+		code  int
+	}{
+		{"no token", "nodes/node-1/address", "", http.StatusUnauthorized},
+		{"wrong token", "nodes/node-1/address", "wrong", http.StatusUnauthorized},
+		{"valid token", "nodes/node-1/address", "token", http.StatusOK},
+		{"node without automq pods", "nodes/node-2/address", "token", http.StatusForbidden},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if code, body := serveAPIs(router, tc.path, tc.token); code != tc.code {
+				t.Errorf("GET %s = %d %s, want %d", tc.path, code, body, tc.code)
+			}
+		})
+	}
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/namespaces/default/automqs/other/nodes/node-1/address", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("GET the address of the missing automq = %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestAPIsNodeAddress(t *testing.T) {
+	for _, tc := range []struct {
+		types []v1.NodeAddressType
+		want  string
+	}{
+		{nil, "10.0.0.1"},
+		{[]v1.NodeAddressType{v1.NodeExternalIP, v1.NodeInternalIP}, "1.2.3.4"},
+		{[]v1.NodeAddressType{v1.NodeHostName}, "node-1"},
+	} {
+		router, _ := newAPIsRouter(t, tc.types...)
+		if code, body := serveAPIs(router, "nodes/node-1/address", "token"); code != http.StatusOK || body != tc.want {
+			t.Errorf("GET the address with the types %v = %d %s, want %s", tc.types, code, body, tc.want)
+		}
+	}
+	router, _ := newAPIsRouter(t)
+	if code, body := serveAPIs(router, "nodes/node-1/label?key=topology.kubernetes.io/zone", "token"); code != http.StatusOK || body != "zone-a" {
+		t.Errorf("GET the node label = %d %s, want zone-a", code, body)
+	}
+}
+
+func TestAPIsPodListeners(t *testing.T) {
+	router, _ := newAPIsRouter(t, v1.NodeExternalIP)
+	code, body := serveAPIs(router, "pods/automq-broker-0-abc/advertised-listeners", "token")
+	if code != http.StatusOK || body != "PLAINTEXT://1.2.3.4:30001" {
+		t.Errorf("GET the advertised listeners = %d %s, want PLAINTEXT://1.2.3.4:30001", code, body)
+	}
+	code, body = serveAPIs(router, "pods/automq-broker-0-abc/listeners", "token")
+	if code != http.StatusOK || !strings.Contains(body, `"listeners":["PLAINTEXT://0.0.0.0:9092"]`) ||
+		!strings.Contains(body, `"advertisedListeners":["PLAINTEXT://1.2.3.4:30001"]`) {
+		t.Errorf("GET the listeners = %d %s", code, body)
+	}
+	if code, _ = serveAPIs(router, "pods/not-found/listeners", "token"); code != http.StatusNotFound {
+		t.Errorf("GET the listeners of the missing pod = %d, want %d", code, http.StatusNotFound)
+	}
+}
+
+func TestAPIsPodListenersLoadBalancer(t *testing.T) {
+	ctx := context.Background()
+	router, k8sClient := newAPIsRouter(t)
+	obj := &infrav1.AutoMQ{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "automq"}, obj); err != nil {
+		t.Fatal(err)
+	}
+	obj.Spec.Exposure = infrav1.ExposureSpec{Type: infrav1.ExposureTypeLoadBalancer}
+	if err := k8sClient.Update(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	if code, _ := serveAPIs(router, "pods/automq-broker-0-abc/advertised-listeners", "token"); code != http.StatusConflict {
+		t.Errorf("GET the advertised listeners of the pending LoadBalancer = %d, want %d", code, http.StatusConflict)
+	}
+	svc := &v1.Service{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "automq-broker-0"}, svc); err != nil {
+		t.Fatal(err)
+	}
+	svc.Status.LoadBalancer.Ingress = []v1.LoadBalancerIngress{{IP: "5.6.7.8"}}
+	if err := k8sClient.Status().Update(ctx, svc); err != nil {
+		t.Fatal(err)
+	}
+	code, body := serveAPIs(router, "pods/automq-broker-0-abc/advertised-listeners", "token")
+	if code != http.StatusOK || body != "PLAINTEXT://5.6.7.8:9092" {
+		t.Errorf("GET the advertised listeners = %d %s, want PLAINTEXT://5.6.7.8:9092", code, body)
+	}
+}
+
+func TestAPIsPodListenersTLSRoute(t *testing.T) {
+	ctx := context.Background()
+	router, k8sClient := newAPIsRouter(t)
+	obj := &infrav1.AutoMQ{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "automq"}, obj); err != nil {
+		t.Fatal(err)
+	}
+	obj.Spec.Exposure = infrav1.ExposureSpec{
+		Type: infrav1.ExposureTypeTLSRoute,
+		TLSRoute: &infrav1.TLSRouteSpec{
+			GatewayName:       "kafka",
+			HostnameTemplate:  "b{index}.{name}.kafka.example.com",
+			BootstrapHostname: "{name}.kafka.example.com",
+		},
+	}
+	if err := k8sClient.Update(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	code, body := serveAPIs(router, "pods/automq-broker-0-abc/advertised-listeners", "token")
+	want := "PLAINTEXT://automq-broker-0.default.svc:9092,EXTERNAL://b0.automq.kafka.example.com:443"
+	if code != http.StatusOK || body != want {
+		t.Errorf("GET the advertised listeners = %d %s, want %s", code, body, want)
+	}
+}
+
+func TestAPIsPodListenersHostNetwork(t *testing.T) {
+	ctx := context.Background()
+	router, k8sClient := newAPIsRouter(t, v1.NodeExternalIP)
+	obj := &infrav1.AutoMQ{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "automq"}, obj); err != nil {
+		t.Fatal(err)
+	}
+	obj.Spec.Broker.HostNetwork = true
+	if err := k8sClient.Update(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	code, body := serveAPIs(router, "pods/automq-broker-0-abc/advertised-listeners", "token")
+	if code != http.StatusOK || body != "PLAINTEXT://1.2.3.4:9092" {
+		t.Errorf("GET the advertised listeners = %d %s, want PLAINTEXT://1.2.3.4:9092", code, body)
+	}
+}
+
+func TestBrokerListeners(t *testing.T) {
+	obj := &infrav1.AutoMQ{}
+	obj.Spec.Mode = infrav1.AutoMQModeCombined
+	listeners := brokerListeners(obj, "10.0.0.1", 30001)
+	if strings.Join(listeners.Listeners, ",") != "PLAINTEXT://0.0.0.0:9092,CONTROLLER://0.0.0.0:9093" {
+		t.Errorf("brokerListeners() listeners = %v", listeners.Listeners)
+	}
+}
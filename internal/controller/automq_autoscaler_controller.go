@@ -0,0 +1,252 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	"github.com/cuisongliu/automq-operator/internal/pkg/metrics"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	defaultAutoscalerInterval                  = 30 * time.Second
+	defaultScaleDownStabilizationSeconds int32 = 300
+	autoscalerRateWindow                       = "2m"
+)
+
+// AutoMQAutoscalerReconciler adjusts the broker replicas of the AutoMQ with the autoscaling enabled
+// by the metrics from Prometheus.
+type AutoMQAutoscalerReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	// PrometheusURL is the default URL of the Prometheus, it is used if the AutoMQ does not set one.
+	PrometheusURL string
+	// Interval is the period of the metrics evaluation.
+	Interval time.Duration
+
+	mu sync.Mutex
+	// recommendations are the desired replicas history used by the stabilization windows.
+	recommendations map[types.NamespacedName][]timestampedRecommendation
+}
+
+type timestampedRecommendation struct {
+	replicas  int32
+	timestamp time.Time
+}
+
+//+kubebuilder:rbac:groups=infra.cuisongliu.github.com,resources=automqs,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *AutoMQAutoscalerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	obj := &infrav1.AutoMQ{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		r.forget(req.NamespacedName)
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	as := obj.Spec.Broker.Autoscaling
+	if as == nil || !as.Enable || obj.IsCombined() || obj.IsPaused() || !obj.GetDeletionTimestamp().IsZero() {
+		r.forget(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+	interval := r.Interval
+	if interval == 0 {
+		interval = defaultAutoscalerInterval
+	}
+	// the replicas can not be changed until the running scaling is finished
+	if obj.Status.BrokerScaling != nil || obj.Status.ControllerScaling != nil {
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	address := as.PrometheusURL
+	if address == "" {
+		address = r.PrometheusURL
+	}
+	querier, err := metrics.NewQuerier(metrics.Config{Address: address})
+	if err != nil {
+		r.Recorder.Eventf(obj, v1.EventTypeWarning, "AutoscalerInitFailed", "Failed to create the metrics querier: %s", err)
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+	desired, reason, err := desiredBrokerReplicas(ctx, querier, obj)
+	if err != nil {
+		log.Error(err, "Failed to compute the desired broker replicas", "name", obj.Name, "namespace", obj.Namespace)
+		r.Recorder.Eventf(obj, v1.EventTypeWarning, "AutoscalerMetricsFailed", "Failed to query the broker metrics: %s", err)
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+	current := obj.Spec.Broker.Replicas
+	replicas := r.stabilize(req.NamespacedName, as, current, desired, time.Now())
+	if replicas == current {
+		return ctrl.Result{RequeueAfter: interval}, nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopy())
+	obj.Spec.Broker.Replicas = replicas
+	if err = r.Patch(ctx, obj, patch); err != nil {
+		log.Error(err, "Failed to patch the broker replicas", "name", obj.Name, "namespace", obj.Namespace)
+		return ctrl.Result{}, err
+	}
+	eventReason := "AutoscalerScaleUp"
+	if replicas < current {
+		eventReason = "AutoscalerScaleDown"
+	}
+	r.Recorder.Eventf(obj, v1.EventTypeNormal, eventReason, "Scale the broker replicas from %d to %d, %s", current, replicas, reason)
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// desiredBrokerReplicas computes the replicas for each target of the autoscaling like the HorizontalPodAutoscaler,
+// and returns the largest one limited by the min and max replicas with the reason of it.
+func desiredBrokerReplicas(ctx context.Context, querier metrics.Querier, obj *infrav1.AutoMQ) (int32, string, error) {
+	as := obj.Spec.Broker.Autoscaling
+	podRegex := getAutoMQName(brokerRole, nil) + "-[0-9]+-.*"
+	type target struct {
+		name  string
+		query string
+		// value is the total target of all the brokers divided by the replicas
+		value float64
+	}
+	var targets []target
+	if as.TargetNetworkInBytesPerSecond != nil {
+		targets = append(targets, target{
+			name:  "network in bytes per second",
+			query: fmt.Sprintf(`sum(rate(kafka_network_io_bytes_total{namespace=%q,pod=~%q,direction="in"}[%s]))`, obj.Namespace, podRegex, autoscalerRateWindow),
+			value: float64(*as.TargetNetworkInBytesPerSecond),
+		})
+	}
+	if as.TargetNetworkOutBytesPerSecond != nil {
+		targets = append(targets, target{
+			name:  "network out bytes per second",
+			query: fmt.Sprintf(`sum(rate(kafka_network_io_bytes_total{namespace=%q,pod=~%q,direction="out"}[%s]))`, obj.Namespace, podRegex, autoscalerRateWindow),
+			value: float64(*as.TargetNetworkOutBytesPerSecond),
+		})
+	}
+	if as.TargetCPUUtilization != nil {
+		request := obj.Spec.Broker.Resources.Requests.Cpu().AsApproximateFloat64()
+		if request == 0 {
+			return 0, "", fmt.Errorf("the cpu request of the broker is required by the cpu utilization target")
+		}
+		targets = append(targets, target{
+			name:  "cpu cores",
+			query: fmt.Sprintf(`sum(rate(container_cpu_usage_seconds_total{namespace=%q,pod=~%q,container=%q}[%s]))`, obj.Namespace, podRegex, brokerRole, autoscalerRateWindow),
+			value: request * float64(*as.TargetCPUUtilization) / 100,
+		})
+	}
+	if len(targets) == 0 {
+		return 0, "", fmt.Errorf("no target of the autoscaling is set")
+	}
+
+	var desired int32
+	var reason string
+	for _, t := range targets {
+		value, err := querier.Query(ctx, t.query)
+		if err != nil {
+			return 0, "", err
+		}
+		replicas := int32(math.Ceil(value / t.value))
+		if replicas > desired || reason == "" {
+			desired = replicas
+			reason = fmt.Sprintf("the total %s is %.2f and the target per broker is %.2f", t.name, value, t.value)
+		}
+	}
+	minReplicas := max(as.MinReplicas, 1)
+	if desired < minReplicas {
+		desired = minReplicas
+	}
+	if desired > as.MaxReplicas {
+		desired = as.MaxReplicas
+	}
+	return desired, reason, nil
+}
+
+// stabilize records the desired replicas, and returns the replicas to apply. The lowest recommendation in the scale up
+// window and the highest one in the scale down window are used, so the replicas are not flapping.
+func (r *AutoMQAutoscalerReconciler) stabilize(key types.NamespacedName, as *infrav1.BrokerAutoscaling, current, desired int32, now time.Time) int32 {
+	upWindow := time.Duration(0)
+	if as.ScaleUpStabilizationSeconds != nil {
+		upWindow = time.Duration(*as.ScaleUpStabilizationSeconds) * time.Second
+	}
+	downWindow := time.Duration(defaultScaleDownStabilizationSeconds) * time.Second
+	if as.ScaleDownStabilizationSeconds != nil {
+		downWindow = time.Duration(*as.ScaleDownStabilizationSeconds) * time.Second
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.recommendations == nil {
+		r.recommendations = make(map[types.NamespacedName][]timestampedRecommendation)
+	}
+	history := []timestampedRecommendation{{replicas: desired, timestamp: now}}
+	for _, rec := range r.recommendations[key] {
+		if now.Sub(rec.timestamp) <= max(upWindow, downWindow) {
+			history = append(history, rec)
+		}
+	}
+	r.recommendations[key] = history
+
+	upRecommendation, downRecommendation := desired, desired
+	for _, rec := range history {
+		if now.Sub(rec.timestamp) <= upWindow {
+			upRecommendation = min(upRecommendation, rec.replicas)
+		}
+		if now.Sub(rec.timestamp) <= downWindow {
+			downRecommendation = max(downRecommendation, rec.replicas)
+		}
+	}
+	replicas := current
+	if replicas < upRecommendation {
+		replicas = upRecommendation
+	}
+	if replicas > downRecommendation {
+		replicas = downRecommendation
+	}
+	return replicas
+}
+
+func (r *AutoMQAutoscalerReconciler) forget(key types.NamespacedName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.recommendations, key)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AutoMQAutoscalerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	r.Scheme = mgr.GetScheme()
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("automq-autoscaler")
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("automq-autoscaler").
+		For(&infrav1.AutoMQ{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Complete(r)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	"github.com/cuisongliu/automq-operator/internal/pkg/metrics"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+// newFakePrometheus returns a server answering the instant queries with the value of the first matched metric.
+func newFakePrometheus(t *testing.T, values map[string]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/query" {
+			http.NotFound(w, req)
+			return
+		}
+		_ = req.ParseForm()
+		query := req.Form.Get("query")
+		result := ""
+		for key, value := range values {
+			if strings.Contains(query, key) {
+				result = fmt.Sprintf(`{"metric":{},"value":[%d,"%s"]}`, time.Now().Unix(), value)
+				break
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[%s]}}`, result)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newAutoscalingAutoMQ(as *infrav1.BrokerAutoscaling) *infrav1.AutoMQ {
+	obj := &infrav1.AutoMQ{}
+	obj.Name = "automq"
+	obj.Namespace = "default"
+	obj.Spec.Broker.Replicas = 3
+	obj.Spec.Broker.Resources.Requests = v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m")}
+	obj.Spec.Broker.Autoscaling = as
+	return obj
+}
+
+func TestDesiredBrokerReplicas(t *testing.T) {
+	server := newFakePrometheus(t, map[string]string{
+		`direction="in"`:              "350",
+		`direction="out"`:             "100",
+		"container_cpu_usage_seconds": "1.2",
+	})
+	querier, err := metrics.NewQuerier(metrics.Config{Address: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		as   *infrav1.BrokerAutoscaling
+		want int32
+	}{
+		{
+			name: "network in",
+			as:   &infrav1.BrokerAutoscaling{Enable: true, MinReplicas: 1, MaxReplicas: 10, TargetNetworkInBytesPerSecond: ptr.To[int64](100)},
+			want: 4,
+		},
+		{
+			name: "largest target",
+			as: &infrav1.BrokerAutoscaling{Enable: true, MinReplicas: 1, MaxReplicas: 10,
+				TargetNetworkInBytesPerSecond: ptr.To[int64](100), TargetNetworkOutBytesPerSecond: ptr.To[int64](10)},
+			want: 10,
+		},
+		{
+			name: "cpu utilization",
+			as:   &infrav1.BrokerAutoscaling{Enable: true, MinReplicas: 1, MaxReplicas: 10, TargetCPUUtilization: ptr.To[int32](80)},
+			want: 3,
+		},
+		{
+			name: "max replicas",
+			as:   &infrav1.BrokerAutoscaling{Enable: true, MinReplicas: 1, MaxReplicas: 2, TargetNetworkInBytesPerSecond: ptr.To[int64](100)},
+			want: 2,
+		},
+		{
+			name: "min replicas",
+			as:   &infrav1.BrokerAutoscaling{Enable: true, MinReplicas: 5, MaxReplicas: 10, TargetNetworkOutBytesPerSecond: ptr.To[int64](1000)},
+			want: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := desiredBrokerReplicas(context.Background(), querier, newAutoscalingAutoMQ(tt.as))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("desiredBrokerReplicas() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDesiredBrokerReplicasNoData(t *testing.T) {
+	server := newFakePrometheus(t, nil)
+	querier, err := metrics.NewQuerier(metrics.Config{Address: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	as := &infrav1.BrokerAutoscaling{Enable: true, MinReplicas: 1, MaxReplicas: 10, TargetNetworkInBytesPerSecond: ptr.To[int64](100)}
+	if _, _, err = desiredBrokerReplicas(context.Background(), querier, newAutoscalingAutoMQ(as)); err == nil {
+		t.Error("desiredBrokerReplicas() expected an error without the metrics")
+	}
+}
+
+func TestStabilize(t *testing.T) {
+	r := &AutoMQAutoscalerReconciler{}
+	key := types.NamespacedName{Namespace: "default", Name: "automq"}
+	as := &infrav1.BrokerAutoscaling{ScaleUpStabilizationSeconds: ptr.To[int32](60), ScaleDownStabilizationSeconds: ptr.To[int32](300)}
+	now := time.Now()
+	steps := []struct {
+		offset  time.Duration
+		desired int32
+		want    int32
+	}{
+		// the scale up waits for the recommendations in the window
+		{0, 3, 3},
+		{30 * time.Second, 5, 3},
+		{100 * time.Second, 6, 6},
+		// the scale down uses the highest recommendation in the window
+		{120 * time.Second, 2, 6},
+		{420 * time.Second, 2, 2},
+	}
+	current := int32(3)
+	for i, step := range steps {
+		current = r.stabilize(key, as, current, step.desired, now.Add(step.offset))
+		if current != step.want {
+			t.Fatalf("step %d: stabilize() = %d, want %d", i, current, step.want)
+		}
+	}
+}
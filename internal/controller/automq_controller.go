@@ -23,19 +23,22 @@ import (
 	"strings"
 	"time"
 
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
-
-	infrav1beta1 "github.com/cuisongliu/automq-operator/api/v1beta1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
 	"github.com/cuisongliu/automq-operator/defaults"
 	"github.com/cuisongliu/automq-operator/internal/pkg/storage"
 	"github.com/labring/operator-sdk/controller"
 	"github.com/labring/operator-sdk/hash"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -48,20 +51,30 @@ import (
 // AutoMQReconciler reconciles a AutoMQ object
 type AutoMQReconciler struct {
 	client.Client
+	// APIReader reads the S3 credentials secret without caching all the secrets of the cluster
+	APIReader client.Reader
 	Scheme    *runtime.Scheme
 	Recorder  record.EventRecorder
 	Finalizer string
 	MountTZ   bool
+	// APIsAddress is the URL of the operator APIs called by the AutoMQ pods
+	APIsAddress string
+	// APIsCAFile is the CA of the operator APIs served over TLS, it is passed to the AutoMQ pods
+	APIsCAFile string
 }
 
 type ctxKey string
 
 // finalizeSetting will perform the required operations before delete the CR.
-func (r *AutoMQReconciler) doFinalizerOperationsForSetting(ctx context.Context, automq *infrav1beta1.AutoMQ) error {
-	return r.cleanup(ctx, automq)
+// The finalizer is kept until the returned result is zero, e.g. the S3 bucket is purged page by page.
+func (r *AutoMQReconciler) doFinalizerOperationsForSetting(ctx context.Context, automq *infrav1.AutoMQ) (ctrl.Result, error) {
+	if err := r.cleanup(ctx, automq); err != nil {
+		return ctrl.Result{}, err
+	}
+	return r.purgeS3Data(ctx, automq)
 }
 
-func (r *AutoMQReconciler) cleanup(ctx context.Context, automq *infrav1beta1.AutoMQ) error {
+func (r *AutoMQReconciler) cleanup(ctx context.Context, automq *infrav1.AutoMQ) error {
 	err := r.cleanBroker(ctx, automq)
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
@@ -83,12 +96,22 @@ func (r *AutoMQReconciler) cleanup(ctx context.Context, automq *infrav1beta1.Aut
 			return err
 		}
 	}
+	secret := &v1.Secret{}
+	secret.Name = apisTokenSecretName(automq)
+	secret.Namespace = automq.Namespace
+	err = r.Client.Delete(ctx, secret)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
 	return nil
 }
 
 //+kubebuilder:rbac:groups=infra.cuisongliu.github.com,resources=automqs,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=infra.cuisongliu.github.com,resources=automqs/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=infra.cuisongliu.github.com,resources=automqs/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;create;update;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -105,7 +128,7 @@ func (r *AutoMQReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	// Fetch the Setting instance
 	// The purpose is check if the Custom Resource for the Kind Setting
 	// is applied on the cluster if not we return nil to stop the reconciliation
-	autoMQ := &infrav1beta1.AutoMQ{}
+	autoMQ := &infrav1.AutoMQ{}
 
 	err := r.Get(ctx, req.NamespacedName, autoMQ)
 	if err != nil {
@@ -113,9 +136,15 @@ func (r *AutoMQReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	if autoMQ.GetDeletionTimestamp() != nil && !autoMQ.GetDeletionTimestamp().IsZero() {
-		if err = r.doFinalizerOperationsForSetting(ctx, autoMQ); err != nil {
+		result, err := r.doFinalizerOperationsForSetting(ctx, autoMQ)
+		if err != nil {
+			r.Recorder.Eventf(autoMQ, v1.EventTypeWarning, "CleanupFailed", "Failed to clean up the resources of the AutoMQ: %s", err)
 			return ctrl.Result{}, err
 		}
+		if !result.IsZero() {
+			return result, nil
+		}
+		r.Recorder.Event(autoMQ, v1.EventTypeNormal, "CleanedUp", "The resources of the AutoMQ are cleaned up")
 		if controllerutil.ContainsFinalizer(autoMQ, autoMQFinalizer) {
 			controllerutil.RemoveFinalizer(autoMQ, autoMQFinalizer)
 		}
@@ -127,19 +156,6 @@ func (r *AutoMQReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, nil
 	}
 
-	ticker := time.NewTicker(5 * time.Second)
-	go func() {
-		for {
-			select {
-			case <-ticker.C:
-				if err = r.statusReconcile(ctx, autoMQ); err != nil {
-					lg := log.FromContext(ctx)
-					lg.Error(err, "Failed to update automq status")
-				}
-			}
-		}
-	}()
-
 	if autoMQ.GetDeletionTimestamp().IsZero() || autoMQ.GetDeletionTimestamp() == nil {
 		controllerutil.AddFinalizer(autoMQ, autoMQFinalizer)
 		if err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -147,7 +163,24 @@ func (r *AutoMQReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		}); err != nil {
 			return ctrl.Result{}, err
 		}
-		return r.reconcile(ctx, autoMQ)
+		var result ctrl.Result
+		if autoMQ.IsPaused() {
+			err = r.pause(ctx, autoMQ)
+		} else {
+			result, err = r.reconcile(ctx, autoMQ)
+		}
+		if err != nil {
+			return result, err
+		}
+		// the pods are not watched, the ready pods and the phase are refreshed on every requeue
+		if err = r.statusReconcile(ctx, autoMQ); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to update automq status")
+			return ctrl.Result{}, err
+		}
+		if result.RequeueAfter == 0 || result.RequeueAfter > statusRequeueInterval {
+			result.RequeueAfter = statusRequeueInterval
+		}
+		return result, nil
 	}
 
 	return ctrl.Result{}, errors.New("reconcile error from Finalizer")
@@ -156,58 +189,118 @@ func (r *AutoMQReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 func (r *AutoMQReconciler) reconcile(ctx context.Context, obj client.Object) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
 	log.V(1).Info("update reconcile controller automq", "request", client.ObjectKeyFromObject(obj))
-	automq, ok := obj.(*infrav1beta1.AutoMQ)
+	automq, ok := obj.(*infrav1.AutoMQ)
 	var err error
 	if !ok {
 		return ctrl.Result{}, errors.New("obj convert automq is error")
 	}
+	if meta.RemoveStatusCondition(&automq.Status.Conditions, infrav1.ConditionPaused) {
+		r.Recorder.Event(automq, v1.EventTypeNormal, "Resumed", "The reconciliation is resumed")
+	}
+	initBrokerIDOffset(automq)
 	automq.Status.ControllerAddresses = r.controllerVoters(automq)
-	pipelines := []func(ctx context.Context, mq *infrav1beta1.AutoMQ) bool{
+	automq.Status.BrokerSelector = labels.SelectorFromSet(getAutoMQLabelMap(automq.GetName(), brokerRole)).String()
+	pipelines := []func(ctx context.Context, mq *infrav1.AutoMQ) (ctrl.Result, error){
 		r.s3Service,
 		r.scriptConfigmap,
+		r.syncAPIsToken,
 		r.syncControllersScale,
 		r.syncControllers,
 		r.syncBrokerScale,
 		r.syncBrokers,
 		r.syncKafkaBootstrapService,
+		r.syncTLSRoutes,
 	}
-	var ifRunning bool
+	// the pipelines stop at the first error or requeue, the failed one reports it by its condition
+	var result ctrl.Result
+	completed := true
 	for index, fn := range pipelines {
-		ifRunning = fn(ctx, automq)
-		log.V(1).Info("update reconcile controller automq", "ifRunning", ifRunning, "index", index)
-		if !ifRunning {
+		result, err = fn(ctx, automq)
+		log.V(1).Info("update reconcile controller automq", "result", result, "index", index, "error", err)
+		if err != nil || !result.IsZero() {
+			completed = false
 			break
 		}
 	}
-	automq.Status.ControllerReplicas = automq.Spec.Controller.Replicas
-	automq.Status.BrokerReplicas = automq.Spec.Broker.Replicas
-	err = r.syncStatus(ctx, automq)
-	return ctrl.Result{}, err
+	// the replicas in the status are the applied ones, they are not changed until the pipelines are finished
+	if completed && automq.Status.ControllerScaling == nil && automq.Status.BrokerScaling == nil {
+		automq.Status.ObservedGeneration = automq.Generation
+	}
+	if completed && automq.Status.ControllerScaling == nil {
+		automq.Status.ControllerReplicas = controllerReplicas(automq)
+	}
+	if completed && automq.Status.BrokerScaling == nil {
+		if automq.Status.BrokerReplicas != 0 && automq.Status.BrokerReplicas < automq.Spec.Broker.Replicas {
+			r.Recorder.Eventf(automq, v1.EventTypeNormal, "ScaledUpBrokers", "Scaled up the brokers from %d to %d", automq.Status.BrokerReplicas, automq.Spec.Broker.Replicas)
+		}
+		automq.Status.BrokerReplicas = automq.Spec.Broker.Replicas
+	}
+	if statusErr := r.syncStatus(ctx, automq); statusErr != nil {
+		err = errors.Join(err, statusErr)
+	}
+	if err != nil {
+		r.Recorder.Eventf(automq, v1.EventTypeWarning, "ReconcileFailed", "Failed to reconcile the AutoMQ: %s", err)
+		return ctrl.Result{}, err
+	}
+	if automq.Status.ControllerScaling != nil || automq.Status.BrokerScaling != nil {
+		// the status changes do not trigger the reconcile, check the progress of the scaling later
+		if result.RequeueAfter == 0 || result.RequeueAfter > scalingRequeueInterval {
+			result.RequeueAfter = scalingRequeueInterval
+		}
+	}
+	return result, nil
+}
+
+// pause skips all the changes of the resources, only the status is updated until the annotation is removed.
+func (r *AutoMQReconciler) pause(ctx context.Context, automq *infrav1.AutoMQ) error {
+	log.FromContext(ctx).Info("the reconciliation is paused", "request", client.ObjectKeyFromObject(automq))
+	if !meta.IsStatusConditionTrue(automq.Status.Conditions, infrav1.ConditionPaused) {
+		r.Recorder.Eventf(automq, v1.EventTypeNormal, "Paused", "The reconciliation is paused by the annotation %s", infrav1.PausedAnnotation)
+	}
+	meta.SetStatusCondition(&automq.Status.Conditions, metav1.Condition{
+		Type:               infrav1.ConditionPaused,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: automq.Generation,
+		Reason:             "ReconcilePaused",
+		Message:            fmt.Sprintf("The reconciliation is paused by the annotation %s, remove it to resume", infrav1.PausedAnnotation),
+	})
+	return r.syncStatus(ctx, automq)
 }
 
+// scalingRequeueInterval is the interval to check the progress of the scaling and the other waiting steps.
+const scalingRequeueInterval = 5 * time.Second
+
+// statusRequeueInterval is the interval to refresh the status from the pods, the pods are not watched.
+const statusRequeueInterval = 30 * time.Second
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *AutoMQReconciler) SetupWithManager(mgr ctrl.Manager, opts controller.RateLimiterOptions) error {
 	if r.Client == nil {
 		r.Client = mgr.GetClient()
 	}
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
 	r.Scheme = mgr.GetScheme()
 	if r.Recorder == nil {
 		r.Recorder = mgr.GetEventRecorderFor("automq-controller")
 	}
+	r.Recorder = newDedupRecorder(r.Recorder, defaultEventDedupInterval)
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(controllerlib.Options{
 			MaxConcurrentReconciles: controller.GetConcurrent(opts),
 			RateLimiter:             controller.GetRateLimiter(opts),
 		}).
-		For(&infrav1beta1.AutoMQ{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		// the annotations are watched to pause and resume the reconciliation
+		For(&infrav1.AutoMQ{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
 		Complete(r)
 }
 
-func (r *AutoMQReconciler) syncStatus(ctx context.Context, automq *infrav1beta1.AutoMQ) error {
+func (r *AutoMQReconciler) syncStatus(ctx context.Context, automq *infrav1.AutoMQ) error {
 	log := log.FromContext(ctx)
 	// Let's just set the status as Unknown when no status are available
 	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		original := &infrav1beta1.AutoMQ{}
+		original := &infrav1.AutoMQ{}
 		if err := r.Get(ctx, client.ObjectKeyFromObject(automq), original); err != nil {
 			return err
 		}
@@ -220,18 +313,71 @@ func (r *AutoMQReconciler) syncStatus(ctx context.Context, automq *infrav1beta1.
 	return nil
 }
 
-func (r *AutoMQReconciler) s3Service(ctx context.Context, obj *infrav1beta1.AutoMQ) bool {
-	log := log.FromContext(ctx)
-	conditionType := "SyncS3ServiceReady"
-	sg, err := storage.NewBucket(storage.Config{
+// newBucket creates the S3 client with the inline access key or the access key of the credentials secret.
+func (r *AutoMQReconciler) newBucket(ctx context.Context, obj *infrav1.AutoMQ) (storage.Bucket, error) {
+	credentials := obj.Spec.S3.Credentials
+	key, secret := credentials.AccessKeyID, credentials.SecretAccessKey
+	if credentials.SecretName != "" {
+		s := &v1.Secret{}
+		if err := r.APIReader.Get(ctx, client.ObjectKey{Namespace: obj.Namespace, Name: credentials.SecretName}, s); err != nil {
+			return nil, fmt.Errorf("failed to get the S3 credentials secret %s: %w", credentials.SecretName, err)
+		}
+		key, secret = string(s.Data[infrav1.S3AccessKeyIDKey]), string(s.Data[infrav1.S3SecretAccessKeyKey])
+	}
+	return storage.NewBucket(storage.Config{
 		Type:     "s3",
-		Key:      obj.Spec.S3.AccessKeyID,
-		Secret:   obj.Spec.S3.SecretAccessKey,
+		Key:      key,
+		Secret:   secret,
 		Region:   obj.Spec.S3.Region,
 		Endpoint: obj.Spec.S3.Endpoint,
 	})
+}
+
+// s3CredentialEnvs returns the envs of the S3 access key, the key of the credentials secret is referenced
+// so the secret value is not copied into the deployment.
+func s3CredentialEnvs(obj *infrav1.AutoMQ) []v1.EnvVar {
+	credentials := obj.Spec.S3.Credentials
+	if credentials.SecretName == "" {
+		return []v1.EnvVar{
+			{Name: "KAFKA_S3_ACCESS_KEY", Value: credentials.AccessKeyID},
+			{Name: "KAFKA_S3_SECRET_KEY", Value: credentials.SecretAccessKey},
+		}
+	}
+	secretKeyRef := func(key string) *v1.EnvVarSource {
+		return &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: credentials.SecretName},
+			Key:                  key,
+		}}
+	}
+	return []v1.EnvVar{
+		{Name: "KAFKA_S3_ACCESS_KEY", ValueFrom: secretKeyRef(infrav1.S3AccessKeyIDKey)},
+		{Name: "KAFKA_S3_SECRET_KEY", ValueFrom: secretKeyRef(infrav1.S3SecretAccessKeyKey)},
+	}
+}
+
+// ensureBucket creates the bucket when it does not exist, and reports whether the bucket is created by this call.
+func ensureBucket(ctx context.Context, sg storage.Bucket, bucket string) (bool, error) {
+	exists, err := sg.BucketExists(ctx, bucket)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return false, nil
+	}
+	err = sg.MkBucket(ctx, bucket)
+	if err != nil && strings.Contains(err.Error(), "BucketAlready") {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *AutoMQReconciler) s3Service(ctx context.Context, obj *infrav1.AutoMQ) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	conditionType := "SyncS3ServiceReady"
+	sg, err := r.newBucket(ctx, obj)
 	if err != nil {
 		log.Error(err, "Failed to create S3 Bucket interface for the custom resource", "name", obj.Name, "namespace", obj.Namespace)
+		r.Recorder.Eventf(obj, v1.EventTypeWarning, "S3Failed", "Failed to create the S3 client: %s", err)
 		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
 			Type:               conditionType,
 			Status:             metav1.ConditionFalse,
@@ -239,11 +385,12 @@ func (r *AutoMQReconciler) s3Service(ctx context.Context, obj *infrav1beta1.Auto
 			Reason:             "AwsS3ReconcilingInit",
 			Message:            fmt.Sprintf("Failed to create S3 Bucket interface for the custom resource (%s): (%s)", obj.Name, err),
 		})
-		return false
+		return ctrl.Result{}, err
 	}
-	err = sg.MkBucket(ctx, obj.Spec.S3.Bucket)
-	if err != nil && !strings.Contains(err.Error(), "BucketAlready") {
+	created, err := ensureBucket(ctx, sg, obj.Spec.S3.Bucket)
+	if err != nil {
 		log.Error(err, "Failed to create S3 Bucket interface for the custom resource", "name", obj.Name, "namespace", obj.Namespace)
+		r.Recorder.Eventf(obj, v1.EventTypeWarning, "S3Failed", "Failed to create the S3 bucket %s: %s", obj.Spec.S3.Bucket, err)
 		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
 			Type:               conditionType,
 			Status:             metav1.ConditionFalse,
@@ -251,7 +398,10 @@ func (r *AutoMQReconciler) s3Service(ctx context.Context, obj *infrav1beta1.Auto
 			Reason:             "AwsS3ReconcilingBucket",
 			Message:            fmt.Sprintf("Failed to create S3 Bucket interface for the custom resource (%s): (%s)", obj.Name, err),
 		})
-		return false
+		return ctrl.Result{}, err
+	}
+	if created {
+		r.Recorder.Eventf(obj, v1.EventTypeNormal, "BucketCreated", "The S3 bucket %s is created", obj.Spec.S3.Bucket)
 	}
 	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
 		Type:               conditionType,
@@ -260,10 +410,10 @@ func (r *AutoMQReconciler) s3Service(ctx context.Context, obj *infrav1beta1.Auto
 		Reason:             "AwsS3Reconciling",
 		Message:            fmt.Sprintf("S3 Bucket interface for the custom resource (%s) has been created", obj.Name),
 	})
-	return true
+	return ctrl.Result{}, nil
 }
 
-func (r *AutoMQReconciler) scriptConfigmap(ctx context.Context, obj *infrav1beta1.AutoMQ) bool {
+func (r *AutoMQReconciler) scriptConfigmap(ctx context.Context, obj *infrav1.AutoMQ) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
 	conditionType := "SyncConfigmapReady"
 	data, err := defaults.Asset("defaults/up.sh")
@@ -276,7 +426,19 @@ func (r *AutoMQReconciler) scriptConfigmap(ctx context.Context, obj *infrav1beta
 			Reason:             "ConfigmapReconcilingInit",
 			Message:            fmt.Sprintf("Failed to create script configmap for the custom resource (%s): (%s)", obj.Name, err),
 		})
-		return false
+		return ctrl.Result{}, err
+	}
+	drainData, err := defaults.Asset("defaults/drain.sh")
+	if err != nil {
+		log.Error(err, "Failed to create script configmap for the custom resource", "name", obj.Name, "namespace", obj.Namespace)
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.Generation,
+			Reason:             "ConfigmapReconcilingInit",
+			Message:            fmt.Sprintf("Failed to create script configmap for the custom resource (%s): (%s)", obj.Name, err),
+		})
+		return ctrl.Result{}, err
 	}
 	ctx = context.WithValue(ctx, ctxKey("hash-configmap"), hash.Hash(data))
 	if err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -287,13 +449,17 @@ func (r *AutoMQReconciler) scriptConfigmap(ctx context.Context, obj *infrav1beta
 		cm.Namespace = obj.Namespace
 		if change, e = controllerutil.CreateOrUpdate(ctx, r.Client, cm, func() error {
 			cm.Data = map[string]string{
-				"up.sh": string(data),
+				"up.sh":    string(data),
+				"drain.sh": string(drainData),
 			}
 			return nil
 		}); e != nil {
 			return e
 		}
 		log.V(1).Info("create or update configmap  by AutoMQ", "OperationResult", change)
+		if change == controllerutil.OperationResultUpdated {
+			r.Recorder.Eventf(obj, v1.EventTypeNormal, "ConfigUpdated", "The script configmap %s is updated", cm.Name)
+		}
 		return nil
 	}); err != nil {
 		log.Error(err, "Failed to create script configmap for the custom resource", "name", obj.Name, "namespace", obj.Namespace)
@@ -304,7 +470,7 @@ func (r *AutoMQReconciler) scriptConfigmap(ctx context.Context, obj *infrav1beta
 			Reason:             "ConfigmapReconcilingCreate",
 			Message:            fmt.Sprintf("Failed to create script configmap for the custom resource (%s): (%s)", obj.Name, err),
 		})
-		return false
+		return ctrl.Result{}, err
 	}
 
 	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
@@ -314,7 +480,7 @@ func (r *AutoMQReconciler) scriptConfigmap(ctx context.Context, obj *infrav1beta
 		Reason:             "ConfigmapReconciling",
 		Message:            fmt.Sprintf("Script configmap for the custom resource (%s) has been created", obj.Name),
 	})
-	return true
+	return ctrl.Result{}, nil
 }
 
 func getAutoMQLabelMap(name, role string) map[string]string {
@@ -333,6 +499,78 @@ func getAutoMQLabelMap(name, role string) map[string]string {
 	}
 }
 
+// stopNodes scales the deployments of the role with the index in [from, to) to zero,
+// and reports whether all the pods of them are gone.
+func (r *AutoMQReconciler) stopNodes(ctx context.Context, obj *infrav1.AutoMQ, role string, from, to int32) (bool, error) {
+	stopped := true
+	for i := from; i < to; i++ {
+		deploy := &appsv1.Deployment{}
+		deploy.Namespace = obj.Namespace
+		deploy.Name = getAutoMQName(role, &i)
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(deploy), deploy); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		if deploy.Spec.Replicas == nil || *deploy.Spec.Replicas != 0 {
+			if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				if err := r.Client.Get(ctx, client.ObjectKeyFromObject(deploy), deploy); err != nil {
+					return err
+				}
+				deploy.Spec.Replicas = aws.Int32(0)
+				return r.Client.Update(ctx, deploy)
+			}); err != nil {
+				return false, err
+			}
+		}
+		labelMap := getAutoMQLabelMap(obj.GetName(), role)
+		labelMap[autoMQIndexKey] = fmt.Sprintf("%d", i)
+		podNum, err := getPodNum(ctx, r.Client, obj.Namespace, labelMap)
+		if err != nil {
+			return false, err
+		}
+		if podNum != 0 {
+			stopped = false
+		}
+	}
+	return stopped, nil
+}
+
+// controllerReplicas returns the number of the dedicated controller nodes, there is none in combined mode.
+func controllerReplicas(obj *infrav1.AutoMQ) int32 {
+	if obj.IsCombined() {
+		return 0
+	}
+	return obj.Spec.Controller.Replicas
+}
+
+// defaultBrokerIDOffset is the node id of the first broker of the AutoMQ created with the dedicated controllers,
+// it leaves the node ids below it to the controllers however they are scaled.
+const defaultBrokerIDOffset int32 = 1000
+
+// initBrokerIDOffset saves the node id of the first broker when the AutoMQ is created. The brokers of the AutoMQ
+// created before the offset was saved keep the node ids after the running controllers, and the server nodes in
+// combined mode are numbered from 0 like the voters.
+func initBrokerIDOffset(obj *infrav1.AutoMQ) {
+	if obj.Status.BrokerIDOffset != nil {
+		return
+	}
+	offset := defaultBrokerIDOffset
+	switch {
+	case obj.IsCombined():
+		offset = 0
+	case obj.Status.ControllerReplicas != 0 || obj.Status.BrokerReplicas != 0:
+		offset = obj.Status.ControllerReplicas
+	}
+	obj.Status.BrokerIDOffset = &offset
+}
+
+// brokerNodeID returns the node id of the broker, it does not depend on the replicas of the controllers.
+func brokerNodeID(obj *infrav1.AutoMQ, index int32) int32 {
+	return ptr.Deref(obj.Status.BrokerIDOffset, controllerReplicas(obj)) + index
+}
+
 func getAutoMQName(role string, index *int32) string {
 	if index != nil {
 		return "automq-" + role + fmt.Sprintf("-%d", *index)
@@ -19,7 +19,6 @@ package controller
 import (
 	"context"
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
@@ -27,7 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	infrav1beta1 "github.com/cuisongliu/automq-operator/api/v1beta1"
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
 	"github.com/cuisongliu/automq-operator/defaults"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -36,14 +35,18 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 const (
 	brokerRole = "broker"
+	// serverRole is the process role of the broker nodes in combined mode
+	serverRole = "server"
 )
 
-func (r *AutoMQReconciler) cleanBroker(ctx context.Context, obj *infrav1beta1.AutoMQ) error {
+func (r *AutoMQReconciler) cleanBroker(ctx context.Context, obj *infrav1.AutoMQ) error {
 	for i := 0; i < int(obj.Status.BrokerReplicas); i++ {
 		svcc := &v1.Service{}
 		svcc.Namespace = obj.Namespace
@@ -56,37 +59,135 @@ func (r *AutoMQReconciler) cleanBroker(ctx context.Context, obj *infrav1beta1.Au
 		deploy.Name = getAutoMQName(brokerRole, &index)
 		_ = r.Client.Delete(ctx, deploy)
 
-		pvc := &v1.PersistentVolumeClaim{}
-		pvc.Namespace = obj.Namespace
-		pvc.Name = getAutoMQName(brokerRole, &index)
-		_ = r.Client.Delete(ctx, pvc)
+		if deletesPVCs(obj) {
+			pvc := &v1.PersistentVolumeClaim{}
+			pvc.Namespace = obj.Namespace
+			pvc.Name = getAutoMQName(brokerRole, &index)
+			_ = r.Client.Delete(ctx, pvc)
+		}
 	}
 
 	bsvc := &v1.Service{}
 	bsvc.Namespace = obj.Namespace
 	bsvc.Name = getAutoMQName(brokerRole+"-bootstrap", nil)
 	_ = r.Client.Delete(ctx, bsvc)
+	r.deleteDrainJob(ctx, obj, drainActionReassign)
+	r.deleteDrainJob(ctx, obj, drainActionUnregister)
 	return nil
 }
 
-func (r *AutoMQReconciler) syncBrokerScale(ctx context.Context, obj *infrav1beta1.AutoMQ) bool {
+// syncBrokerScale removes the trailing brokers when the replicas is decreased. The brokers are removed by:
+//  1. moving the partitions off the removed brokers with a reassignment job
+//  2. stopping the removed brokers
+//  3. unregistering the removed brokers from the controller quorum with a job
+//  4. deleting the deployments, services and pvcs of the removed brokers
+func (r *AutoMQReconciler) syncBrokerScale(ctx context.Context, obj *infrav1.AutoMQ) (ctrl.Result, error) {
 	conditionType := "SyncBrokerScale"
+	log := log.FromContext(ctx)
 	currentReplicas := obj.Status.BrokerReplicas
-	if currentReplicas > obj.Spec.Broker.Replicas {
-		for i := obj.Spec.Broker.Replicas; i < currentReplicas; i++ {
-			deploy := &appsv1.StatefulSet{}
-			deploy.Namespace = obj.Namespace
-			deploy.Name = getAutoMQName(brokerRole, &i)
-			_ = r.Client.Delete(ctx, deploy)
-			svc := &v1.Service{}
-			svc.Namespace = obj.Namespace
-			svc.Name = getAutoMQName(brokerRole, &i)
-			_ = r.Client.Delete(ctx, svc)
-			pvc := &v1.PersistentVolumeClaim{}
-			pvc.Namespace = obj.Namespace
-			pvc.Name = getAutoMQName(brokerRole, &i)
-			_ = r.Client.Delete(ctx, pvc)
+	desiredReplicas := obj.Spec.Broker.Replicas
+	if obj.IsCombined() && currentReplicas != 0 && currentReplicas != desiredReplicas {
+		// the server nodes are the controller quorum voters in combined mode, the scale subresource
+		// is not covered by the webhook, so the replicas changed by it is rejected here
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.Generation,
+			Reason:             "BrokerScaleCombined",
+			Message:            fmt.Sprintf("The broker replicas can not be changed from %d to %d in combined mode", currentReplicas, desiredReplicas),
+		})
+		r.Recorder.Eventf(obj, v1.EventTypeWarning, "ScaleRejected", "The broker replicas can not be changed from %d to %d in combined mode", currentReplicas, desiredReplicas)
+		// retrying does not help, the reconcile is triggered again when the replicas is changed back
+		return ctrl.Result{}, reconcile.TerminalError(fmt.Errorf("the broker replicas can not be changed from %d to %d in combined mode", currentReplicas, desiredReplicas))
+	}
+	scaling := obj.Status.BrokerScaling
+	if scaling != nil && scaling.To != desiredReplicas && scaling.Step == infrav1.BrokerScalingReassigning {
+		// the replicas is changed again before any broker is stopped, start over with the new target
+		r.deleteDrainJob(ctx, obj, drainActionReassign)
+		currentReplicas = scaling.From
+		scaling = nil
+		obj.Status.BrokerScaling = nil
+	}
+	if scaling == nil && currentReplicas > desiredReplicas {
+		scaling = &infrav1.BrokerScalingStatus{
+			From:      currentReplicas,
+			To:        desiredReplicas,
+			Step:      infrav1.BrokerScalingReassigning,
+			StartTime: metav1.Now(),
+		}
+		for i := desiredReplicas; i < currentReplicas; i++ {
+			scaling.NodeIDs = append(scaling.NodeIDs, brokerNodeID(obj, i))
+		}
+		obj.Status.BrokerScaling = scaling
+		log.Info("start scaling down the brokers", "from", scaling.From, "to", scaling.To, "nodeIDs", scaling.NodeIDs)
+		r.Recorder.Eventf(obj, v1.EventTypeNormal, "ScalingDownBrokers", "Scaling down the brokers from %d to %d, draining the brokers %s", scaling.From, scaling.To, joinNodeIDs(scaling.NodeIDs))
+	}
+	if scaling == nil {
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: obj.Generation,
+			Reason:             "BrokerScaleReconciling",
+			Message:            fmt.Sprintf("Broker scale for the custom resource (%s) has been reconciled", obj.Name),
+		})
+		return ctrl.Result{}, nil
+	}
+	nodeIDs := joinNodeIDs(scaling.NodeIDs)
+	switch scaling.Step {
+	case infrav1.BrokerScalingReassigning:
+		var remainIDs []int32
+		for i := int32(0); i < scaling.To; i++ {
+			remainIDs = append(remainIDs, brokerNodeID(obj, i))
+		}
+		state, err := r.syncDrainJob(ctx, obj, drainActionReassign, nodeIDs, joinNodeIDs(remainIDs))
+		if done, err := r.checkDrainJob(ctx, obj, conditionType, "BrokerScaleReassigning", state, err,
+			fmt.Sprintf("Scaling down the brokers from %d to %d: moving the partitions off the brokers %s", scaling.From, scaling.To, nodeIDs)); !done {
+			return ctrl.Result{}, err
+		}
+		r.deleteDrainJob(ctx, obj, drainActionReassign)
+		scaling.DrainRetries = 0
+		scaling.Step = infrav1.BrokerScalingStopping
+		fallthrough
+	case infrav1.BrokerScalingStopping:
+		stopped, err := r.stopNodes(ctx, obj, brokerRole, scaling.To, scaling.From)
+		if err != nil {
+			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+				Type:               conditionType,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: obj.Generation,
+				Reason:             "BrokerScaleStopping",
+				Message:            fmt.Sprintf("Failed to stop the brokers for the custom resource (%s): (%s)", obj.Name, err),
+			})
+			log.Error(err, "Failed to stop the brokers for the custom resource", "name", obj.Name, "role", brokerRole)
+			return ctrl.Result{}, err
 		}
+		if !stopped {
+			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+				Type:               conditionType,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: obj.Generation,
+				Reason:             "BrokerScaleStopping",
+				Message:            fmt.Sprintf("Scaling down the brokers from %d to %d: waiting for the brokers %s to stop", scaling.From, scaling.To, nodeIDs),
+			})
+			return ctrl.Result{}, nil
+		}
+		scaling.DrainRetries = 0
+		scaling.Step = infrav1.BrokerScalingUnregistering
+		fallthrough
+	case infrav1.BrokerScalingUnregistering:
+		state, err := r.syncDrainJob(ctx, obj, drainActionUnregister, nodeIDs)
+		if done, err := r.checkDrainJob(ctx, obj, conditionType, "BrokerScaleUnregistering", state, err,
+			fmt.Sprintf("Scaling down the brokers from %d to %d: unregistering the brokers %s", scaling.From, scaling.To, nodeIDs)); !done {
+			return ctrl.Result{}, err
+		}
+		r.deleteDrainJob(ctx, obj, drainActionUnregister)
+		for i := scaling.To; i < scaling.From; i++ {
+			r.deleteBroker(ctx, obj, i)
+		}
+		log.Info("finish scaling down the brokers", "from", scaling.From, "to", scaling.To, "nodeIDs", scaling.NodeIDs)
+		r.Recorder.Eventf(obj, v1.EventTypeNormal, "ScaledDownBrokers", "Scaled down the brokers from %d to %d", scaling.From, scaling.To)
+		obj.Status.BrokerScaling = nil
+		obj.Status.BrokerReplicas = scaling.To
 	}
 	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
 		Type:               conditionType,
@@ -95,10 +196,72 @@ func (r *AutoMQReconciler) syncBrokerScale(ctx context.Context, obj *infrav1beta
 		Reason:             "BrokerScaleReconciling",
 		Message:            fmt.Sprintf("Broker scale for the custom resource (%s) has been reconciled", obj.Name),
 	})
-	return true
+	return ctrl.Result{}, nil
+}
+
+// checkDrainJob sets the condition by the state of the drain job and reports whether the job is succeeded,
+// the error is returned when the job can not be synced, the failed job is reported until it is created again.
+func (r *AutoMQReconciler) checkDrainJob(ctx context.Context, obj *infrav1.AutoMQ, conditionType, reason string, state drainJobState, err error, message string) (bool, error) {
+	log := log.FromContext(ctx)
+	if err != nil {
+		log.Error(err, "Failed to sync the drain job for the custom resource", "name", obj.Name, "role", brokerRole)
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.Generation,
+			Reason:             reason,
+			Message:            fmt.Sprintf("Failed to sync the drain job for the custom resource (%s): (%s)", obj.Name, err),
+		})
+		return false, err
+	}
+	switch state {
+	case drainJobFailed:
+		// the failed job is created again after the backoff, the scale-down is retried until it succeeds
+		// or the replicas is changed back
+		var retries int32
+		if obj.Status.BrokerScaling != nil {
+			retries = obj.Status.BrokerScaling.DrainRetries
+		}
+		failed := fmt.Sprintf("%s failed %d times, it is retried after %s, check the logs of the drain job", message, retries+1, drainJobBackoff(retries))
+		if meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.Generation,
+			Reason:             reason + "Failed",
+			Message:            failed,
+		}) {
+			r.Recorder.Event(obj, v1.EventTypeWarning, reason+"Failed", failed)
+		}
+		return false, nil
+	case drainJobRunning:
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.Generation,
+			Reason:             reason,
+			Message:            message,
+		})
+		return false, nil
+	}
+	return true, nil
+}
+
+func (r *AutoMQReconciler) deleteBroker(ctx context.Context, obj *infrav1.AutoMQ, index int32) {
+	deploy := &appsv1.Deployment{}
+	deploy.Namespace = obj.Namespace
+	deploy.Name = getAutoMQName(brokerRole, &index)
+	_ = r.Client.Delete(ctx, deploy)
+	svc := &v1.Service{}
+	svc.Namespace = obj.Namespace
+	svc.Name = getAutoMQName(brokerRole, &index)
+	_ = r.Client.Delete(ctx, svc)
+	pvc := &v1.PersistentVolumeClaim{}
+	pvc.Namespace = obj.Namespace
+	pvc.Name = getAutoMQName(brokerRole, &index)
+	_ = r.Client.Delete(ctx, pvc)
 }
 
-func (r *AutoMQReconciler) syncBrokers(ctx context.Context, obj *infrav1beta1.AutoMQ) bool {
+func (r *AutoMQReconciler) syncBrokers(ctx context.Context, obj *infrav1.AutoMQ) (ctrl.Result, error) {
 	conditionType := "SyncBrokerReady"
 	log := log.FromContext(ctx)
 	// 1. sync pvc
@@ -106,7 +269,13 @@ func (r *AutoMQReconciler) syncBrokers(ctx context.Context, obj *infrav1beta1.Au
 	// 3. sync svc
 	// 3. sync monitor
 
-	for i := 0; i < int(obj.Spec.Broker.Replicas); i++ {
+	replicas := obj.Spec.Broker.Replicas
+	if obj.Status.BrokerScaling != nil {
+		// the removed brokers are managed by the scale-down
+		replicas = min(replicas, obj.Status.BrokerScaling.To)
+	}
+	var pending []string
+	for i := 0; i < int(replicas); i++ {
 		if err := r.syncBrokerPVC(ctx, obj, int32(i)); err != nil {
 			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
 				Type:               conditionType,
@@ -116,9 +285,10 @@ func (r *AutoMQReconciler) syncBrokers(ctx context.Context, obj *infrav1beta1.Au
 				Message:            fmt.Sprintf("Failed to create pvc for the custom resource (%s): (%s)", obj.Name, err),
 			})
 			log.Error(err, "Failed to create pvc for the custom resource", "name", obj.Name, "role", brokerRole)
-			return true
+			return ctrl.Result{}, err
 		}
-		if err := r.syncBrokerService(ctx, obj, int32(i)); err != nil {
+		svc, err := r.syncBrokerService(ctx, obj, int32(i))
+		if err != nil {
 			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
 				Type:               conditionType,
 				Status:             metav1.ConditionFalse,
@@ -127,7 +297,14 @@ func (r *AutoMQReconciler) syncBrokers(ctx context.Context, obj *infrav1beta1.Au
 				Message:            fmt.Sprintf("Failed to create service for the custom resource (%s): (%s)", obj.Name, err),
 			})
 			log.Error(err, "Failed to create service for the custom resource", "name", obj.Name, "role", brokerRole)
-			return true
+			return ctrl.Result{}, err
+		}
+		// the broker advertises the ingress of its LoadBalancer service, so it is started after the ingress is assigned
+		if obj.IsLoadBalancer() {
+			if _, ok := loadBalancerAddress(svc, brokerHostname(obj, int32(i))); !ok {
+				pending = append(pending, svc.Name)
+				continue
+			}
 		}
 		if err := r.syncBrokerDeploy(ctx, obj, int32(i)); err != nil {
 			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
@@ -138,9 +315,20 @@ func (r *AutoMQReconciler) syncBrokers(ctx context.Context, obj *infrav1beta1.Au
 				Message:            fmt.Sprintf("Failed to create deploy for the custom resource (%s): (%s)", obj.Name, err),
 			})
 			log.Error(err, "Failed to create deploy for the custom resource", "name", obj.Name, "role", brokerRole)
-			return true
+			return ctrl.Result{}, err
 		}
 	}
+	if len(pending) != 0 {
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.Generation,
+			Reason:             "BrokerLoadBalancerPending",
+			Message:            fmt.Sprintf("Waiting for the ingress of the LoadBalancer services %s", strings.Join(pending, ", ")),
+		})
+		log.Info("Waiting for the ingress of the LoadBalancer services", "name", obj.Name, "services", pending)
+		return ctrl.Result{RequeueAfter: loadBalancerRequeueInterval}, nil
+	}
 	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
 		Type:               conditionType,
 		Status:             metav1.ConditionTrue,
@@ -148,10 +336,10 @@ func (r *AutoMQReconciler) syncBrokers(ctx context.Context, obj *infrav1beta1.Au
 		Reason:             "BrokerReconciling",
 		Message:            fmt.Sprintf("Broker resource for the custom resource (%s) has been created or update", obj.Name),
 	})
-	return true
+	return ctrl.Result{}, nil
 }
 
-func (r *AutoMQReconciler) syncBrokerPVC(ctx context.Context, obj *infrav1beta1.AutoMQ, index int32) error {
+func (r *AutoMQReconciler) syncBrokerPVC(ctx context.Context, obj *infrav1.AutoMQ, index int32) error {
 	storage, _ := resource.ParseQuantity("100Gi")
 	pvc := &v1.PersistentVolumeClaim{}
 	pvc.Namespace = obj.Namespace
@@ -179,7 +367,7 @@ func (r *AutoMQReconciler) syncBrokerPVC(ctx context.Context, obj *infrav1beta1.
 	return nil
 }
 
-func (r *AutoMQReconciler) syncBrokerDeploy(ctx context.Context, obj *infrav1beta1.AutoMQ, index int32) error {
+func (r *AutoMQReconciler) syncBrokerDeploy(ctx context.Context, obj *infrav1.AutoMQ, index int32) error {
 	deploy := &appsv1.Deployment{}
 	deploy.Namespace = obj.Namespace
 	deploy.Name = getAutoMQName(brokerRole, &index)
@@ -196,7 +384,6 @@ func (r *AutoMQReconciler) syncBrokerDeploy(ctx context.Context, obj *infrav1bet
 		return err
 	}
 
-	sysctl := sysctlContainer()
 	envs := []v1.EnvVar{
 		{
 			Name: "NAMESPACE_NAME",
@@ -230,46 +417,33 @@ func (r *AutoMQReconciler) syncBrokerDeploy(ctx context.Context, obj *infrav1bet
 				},
 			},
 		},
-		{
-			Name:  "KAFKA_S3_ACCESS_KEY",
-			Value: obj.Spec.S3.AccessKeyID,
-		},
-		{
-			Name:  "KAFKA_S3_SECRET_KEY",
-			Value: obj.Spec.S3.SecretAccessKey,
-		},
+	}
+	envs = append(envs, s3CredentialEnvs(obj)...)
+	envs = append(envs, []v1.EnvVar{
 		{
 			Name:  "KAFKA_HEAP_OPTS",
 			Value: strings.Join(obj.Spec.Broker.JVMOptions, " "),
 		},
-		{
-			Name:  "KAFKA_CFG_AUTOBALANCER_REPORTER_NETWORK_IN_CAPACITY",
-			Value: "5120",
-		},
-		{
-			Name:  "KAFKA_CFG_AUTOBALANCER_REPORTER_NETWORK_OUT_CAPACITY",
-			Value: "5120",
-		},
-		{
-			Name:  "KAFKA_CFG_AUTOBALANCER_REPORTER_METRICS_REPORTING_INTERVAL_MS",
-			Value: "5000",
-		},
 		{
 			Name:  "NODEPORT_DEFAULT_PORT",
 			Value: strconv.Itoa(int(svc.Spec.Ports[0].NodePort)),
 		},
-		{
-			Name:  "OPERATOR_APIS_ADDR",
-			Value: fmt.Sprintf("http://%s:%d", os.Getenv("OPERATOR_APIS_IP"), 9090),
-		},
+	}...)
+	envs = append(envs, r.apisEnvs(obj)...)
+	processRole := brokerRole
+	if obj.IsCombined() {
+		processRole = serverRole
 	}
+	envs = append(envs, autoBalancerEnvs(obj, processRole)...)
+	envs = append(envs, rackAwarenessEnvs(obj)...)
+	envs = append(envs, externalListenerEnvs(obj)...)
 	cmds := []string{
 		"/opt/kafka/scripts/mq-start.sh",
 		"up",
 		"--process.roles",
-		"broker",
+		processRole,
 		"--node.id",
-		fmt.Sprintf("%d", index+obj.Spec.Controller.Replicas),
+		fmt.Sprintf("%d", brokerNodeID(obj, index)),
 		"--cluster.id",
 		obj.Spec.ClusterID,
 		"--controller.quorum.voters",
@@ -284,18 +458,17 @@ func (r *AutoMQReconciler) syncBrokerDeploy(ctx context.Context, obj *infrav1bet
 		fmt.Sprintf("%t", obj.Spec.S3.EnablePathStyle),
 	}
 	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		_, err := controllerutil.CreateOrUpdate(ctx, r.Client, deploy, func() error {
+		var generation int64
+		change, err := controllerutil.CreateOrUpdate(ctx, r.Client, deploy, func() error {
+			generation = deploy.Generation
 			deploy.Labels = getAutoMQLabelMap(obj.GetName(), brokerRole)
-			deploy.Spec.Strategy = appsv1.DeploymentStrategy{
-				Type: appsv1.RollingUpdateDeploymentStrategyType,
-			}
+			deploy.Spec.Replicas = aws.Int32(1)
+			deploy.Spec.Strategy = brokerDeploymentStrategy(obj)
 			deploy.Spec.Template.Labels = labelMap
-			deploy.Spec.Template.Spec.HostNetwork = false
 			deploy.Spec.Template.Spec.TerminationGracePeriodSeconds = aws.Int64(60 * 2)
-			deploy.Spec.Template.Spec.InitContainers = []v1.Container{
-				sysctl,
-			}
-			deploy.Spec.Template.Spec.Affinity = obj.Spec.Broker.Affinity.ToK8sAffinity()
+			deploy.Spec.Template.Spec.InitContainers = initContainers(obj)
+			deploy.Spec.Template.Spec.Affinity = obj.Spec.Broker.Affinity.ToK8sAffinity(getAutoMQLabelMap(obj.GetName(), brokerRole))
+			applyRackAwareness(&deploy.Spec.Template.Spec, obj)
 			deploy.Spec.Template.Spec.Volumes = []v1.Volume{
 				{
 					Name: "script",
@@ -373,6 +546,13 @@ func (r *AutoMQReconciler) syncBrokerDeploy(ctx context.Context, obj *infrav1bet
 					ImagePullPolicy: v1.PullIfNotPresent,
 				},
 			}
+			if obj.IsCombined() {
+				deploy.Spec.Template.Spec.Containers[0].Ports = append(deploy.Spec.Template.Spec.Containers[0].Ports, v1.ContainerPort{
+					Name:          controllerRole,
+					ContainerPort: 9093,
+					Protocol:      v1.ProtocolTCP,
+				})
+			}
 			hash, ok := ctx.Value(ctxKey("hash-configmap")).(string)
 			if !ok {
 				hash = ""
@@ -390,7 +570,7 @@ func (r *AutoMQReconciler) syncBrokerDeploy(ctx context.Context, obj *infrav1bet
 				deploy.Spec.Template.Annotations["prometheus.io/port"] = "9090"
 				deploy.Spec.Template.Annotations["prometheus.io/path"] = "/metrics"
 			}
-			if r.MountTZ {
+			if r.MountTZ && !obj.Spec.Security.Restricted {
 				deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, v1.Volume{
 					Name: "k8tz",
 					VolumeSource: v1.VolumeSource{
@@ -404,24 +584,33 @@ func (r *AutoMQReconciler) syncBrokerDeploy(ctx context.Context, obj *infrav1bet
 					MountPath: "/etc/localtime",
 				})
 			}
-			if obj.Spec.Broker.Resource.Requests != nil {
-				deploy.Spec.Template.Spec.Containers[0].Resources.Requests = obj.Spec.Broker.Resource.Requests
+			if obj.Spec.Broker.Resources.Requests != nil {
+				deploy.Spec.Template.Spec.Containers[0].Resources.Requests = obj.Spec.Broker.Resources.Requests
 			}
-			if obj.Spec.Broker.Resource.Limits != nil {
-				deploy.Spec.Template.Spec.Containers[0].Resources.Limits = obj.Spec.Broker.Resource.Limits
+			if obj.Spec.Broker.Resources.Limits != nil {
+				deploy.Spec.Template.Spec.Containers[0].Resources.Limits = obj.Spec.Broker.Resources.Limits
 			}
-			if obj.Spec.Broker.Envs != nil && len(obj.Spec.Broker.Envs) > 0 {
-				deploy.Spec.Template.Spec.Containers[0].Env = append(deploy.Spec.Template.Spec.Containers[0].Env, obj.Spec.Broker.Envs...)
+			if obj.Spec.Broker.Env != nil && len(obj.Spec.Broker.Env) > 0 {
+				deploy.Spec.Template.Spec.Containers[0].Env = append(deploy.Spec.Template.Spec.Containers[0].Env, obj.Spec.Broker.Env...)
 			}
+			deploy.Spec.Template.Spec.SecurityContext = &v1.PodSecurityContext{}
+			applyPodSysctls(&deploy.Spec.Template.Spec, obj.Spec.Sysctl)
+			applyExternalListener(&deploy.Spec.Template.Spec, obj)
+			applyHostNetwork(&deploy.Spec.Template.Spec, obj)
+			applyRestrictedSecurity(&deploy.Spec.Template.Spec, obj)
+			applyPodTemplate(&deploy.Spec.Template, obj.Spec.Broker.PodTemplate)
 			return nil
 		})
+		if err == nil && change == controllerutil.OperationResultUpdated && deploy.Generation != generation {
+			r.Recorder.Eventf(obj, v1.EventTypeNormal, "RollingUpdate", "The deployment %s is updated to generation %d, the pod is restarted", deploy.Name, deploy.Generation)
+		}
 		return err
 	}); err != nil {
 		return err
 	}
 	return nil
 }
-func (r *AutoMQReconciler) syncBrokerService(ctx context.Context, obj *infrav1beta1.AutoMQ, index int32) error {
+func (r *AutoMQReconciler) syncBrokerService(ctx context.Context, obj *infrav1.AutoMQ, index int32) (*v1.Service, error) {
 	svc := &v1.Service{}
 	svc.Namespace = obj.Namespace
 	svc.Name = getAutoMQName(brokerRole, &index)
@@ -439,17 +628,32 @@ func (r *AutoMQReconciler) syncBrokerService(ctx context.Context, obj *infrav1be
 					Protocol:   v1.ProtocolTCP,
 				},
 			}
-			svc.Spec.Type = v1.ServiceTypeNodePort
+			if obj.IsCombined() {
+				svc.Spec.Ports = append(svc.Spec.Ports, v1.ServicePort{
+					Name:       controllerRole,
+					Port:       9093,
+					TargetPort: intstr.FromString(controllerRole),
+					Protocol:   v1.ProtocolTCP,
+				})
+			}
+			if obj.IsTLSRoute() {
+				svc.Spec.Ports = append(svc.Spec.Ports, externalServicePort())
+			}
+			applyExposure(obj, svc, brokerHostname(obj, index))
+			// the brokers on the host network advertise the node address, the service only routes in the cluster
+			if obj.Spec.Broker.HostNetwork {
+				svc.Spec.Type = v1.ServiceTypeClusterIP
+			}
 			return nil
 		})
 		return err
 	}); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return svc, nil
 }
 
-func (r *AutoMQReconciler) syncKafkaBootstrapService(ctx context.Context, obj *infrav1beta1.AutoMQ) bool {
+func (r *AutoMQReconciler) syncKafkaBootstrapService(ctx context.Context, obj *infrav1.AutoMQ) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
 	conditionType := "SyncBootstrapServiceReady"
 
@@ -472,7 +676,11 @@ func (r *AutoMQReconciler) syncKafkaBootstrapService(ctx context.Context, obj *i
 					NodePort:   obj.Spec.NodePort,
 				},
 			}
-			svc.Spec.Type = v1.ServiceTypeNodePort
+			if obj.IsTLSRoute() {
+				svc.Spec.Ports[0].NodePort = 0
+				svc.Spec.Ports = append(svc.Spec.Ports, externalServicePort())
+			}
+			applyExposure(obj, svc, bootstrapHostname(obj))
 			return nil
 		}); e != nil {
 			return e
@@ -487,7 +695,8 @@ func (r *AutoMQReconciler) syncKafkaBootstrapService(ctx context.Context, obj *i
 			Reason:             "BootstrapServiceReconciling",
 			Message:            fmt.Sprintf("Failed to create bootstrap service for the custom resource (%s): (%s)", obj.Name, err),
 		})
-		return true
+		log.Error(err, "Failed to create bootstrap service for the custom resource", "name", obj.Name, "role", brokerRole)
+		return ctrl.Result{}, err
 	}
 	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
 		Type:               conditionType,
@@ -497,5 +706,6 @@ func (r *AutoMQReconciler) syncKafkaBootstrapService(ctx context.Context, obj *i
 		Message:            fmt.Sprintf("Bootstrap service for the custom resource (%s) has been created", obj.Name),
 	})
 	obj.Status.BootstrapInternalAddress = fmt.Sprintf("%s.%s.svc:%d", getAutoMQName(brokerRole+"-bootstrap", nil), obj.Namespace, 9092)
-	return true
+	obj.Status.BootstrapExternalAddress = bootstrapExternalAddress(obj, svc)
+	return ctrl.Result{}, nil
 }
@@ -23,7 +23,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	infrav1beta1 "github.com/cuisongliu/automq-operator/api/v1beta1"
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
 	"github.com/cuisongliu/automq-operator/defaults"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -32,15 +32,23 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 const (
 	controllerRole = "controller"
 )
 
-func (r *AutoMQReconciler) cleanController(ctx context.Context, obj *infrav1beta1.AutoMQ) error {
-	for i := 0; i < int(obj.Status.ControllerReplicas); i++ {
+func (r *AutoMQReconciler) cleanController(ctx context.Context, obj *infrav1.AutoMQ) error {
+	replicas := obj.Status.ControllerReplicas
+	if obj.Status.ControllerScaling != nil {
+		replicas = max(replicas, obj.Status.ControllerScaling.To)
+	}
+	for i := 0; i < int(replicas); i++ {
 		svcc := &v1.Service{}
 		svcc.Namespace = obj.Namespace
 		index := int32(i)
@@ -52,32 +60,174 @@ func (r *AutoMQReconciler) cleanController(ctx context.Context, obj *infrav1beta
 		deploy.Name = getAutoMQName(controllerRole, &index)
 		_ = r.Client.Delete(ctx, deploy)
 
-		pvc := &v1.PersistentVolumeClaim{}
-		pvc.Namespace = obj.Namespace
-		pvc.Name = getAutoMQName(controllerRole, &index)
-		_ = r.Client.Delete(ctx, pvc)
+		if deletesPVCs(obj) {
+			pvc := &v1.PersistentVolumeClaim{}
+			pvc.Namespace = obj.Namespace
+			pvc.Name = getAutoMQName(controllerRole, &index)
+			_ = r.Client.Delete(ctx, pvc)
+		}
 	}
 	return nil
 }
 
-func (r *AutoMQReconciler) syncControllersScale(ctx context.Context, obj *infrav1beta1.AutoMQ) bool {
+// syncControllersScale changes the number of the controllers. The default image automqinc/automq:1.2.0 is built on
+// Apache Kafka 3.8, the dynamic quorum (KIP-853, kafka-metadata-quorum.sh add-controller) needs Kafka 3.9, so the
+// controller.quorum.voters is static and the quorum is reconfigured one controller at a time:
+//  1. stopping and deleting the removed controllers, the controllers with the lowest index are kept
+//  2. restarting the kept controllers one by one with the new voters, each one waits for the previous one to be ready
+//  3. starting the added controllers and rolling the brokers with the new voters
+//
+// The kept controllers are the majority of both the old and the new voters, so the quorum is available unless the kept
+// controllers are less than the majority. The stored quorum state with the old voters is removed by up.sh.
+func (r *AutoMQReconciler) syncControllersScale(ctx context.Context, obj *infrav1.AutoMQ) (ctrl.Result, error) {
 	conditionType := "SyncControllerScale"
+	log := log.FromContext(ctx)
 	currentReplicas := obj.Status.ControllerReplicas
-	if currentReplicas > obj.Spec.Controller.Replicas {
-		for i := obj.Spec.Controller.Replicas; i < currentReplicas; i++ {
-			deploy := &appsv1.Deployment{}
-			deploy.Namespace = obj.Namespace
-			deploy.Name = getAutoMQName(controllerRole, &i)
-			_ = r.Client.Delete(ctx, deploy)
-			svc := &v1.Service{}
-			svc.Namespace = obj.Namespace
-			svc.Name = getAutoMQName(controllerRole, &i)
-			_ = r.Client.Delete(ctx, svc)
-			pvc := &v1.PersistentVolumeClaim{}
-			pvc.Namespace = obj.Namespace
-			pvc.Name = getAutoMQName(controllerRole, &i)
-			_ = r.Client.Delete(ctx, pvc)
+	desiredReplicas := controllerReplicas(obj)
+	if offset := brokerNodeID(obj, 0); desiredReplicas > offset {
+		// the node ids of the controllers would take the node ids of the brokers saved in their volumes
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.Generation,
+			Reason:             "ControllerScaleNodeID",
+			Message:            fmt.Sprintf("The controller replicas can not be more than %d, the node ids from %d are taken by the brokers", offset, offset),
+		})
+		r.Recorder.Eventf(obj, v1.EventTypeWarning, "ScaleRejected", "The controller replicas can not be more than %d, the node ids from %d are taken by the brokers", offset, offset)
+		// retrying does not help, the reconcile is triggered again when the replicas is changed back
+		return ctrl.Result{}, reconcile.TerminalError(fmt.Errorf("the controller replicas %d takes the node ids of the brokers from %d", desiredReplicas, offset))
+	}
+	scaling := obj.Status.ControllerScaling
+	if scaling == nil && currentReplicas != 0 && currentReplicas != desiredReplicas {
+		scaling = &infrav1.ControllerScalingStatus{
+			From:      currentReplicas,
+			To:        desiredReplicas,
+			Step:      infrav1.ControllerScalingStopping,
+			StartTime: metav1.Now(),
+		}
+		obj.Status.ControllerScaling = scaling
+		log.Info("start scaling the controllers", "from", scaling.From, "to", scaling.To)
+		r.Recorder.Eventf(obj, v1.EventTypeNormal, "ScalingControllers", "Scaling the controllers from %d to %d, the controllers are restarted one by one with the new voters", scaling.From, scaling.To)
+	}
+	if scaling == nil {
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: obj.Generation,
+			Reason:             "ControllerScaleReconciling",
+			Message:            fmt.Sprintf("Controller scale for the custom resource (%s) has been reconciled", obj.Name),
+		})
+		return ctrl.Result{}, nil
+	}
+	if scaling.To != desiredReplicas {
+		// the replicas is changed again during the scaling, start over with the new target from the started controllers
+		if scaling.Step == infrav1.ControllerScalingStarting {
+			scaling.From = scaling.To
+		}
+		scaling.To = desiredReplicas
+		scaling.Step = infrav1.ControllerScalingStopping
+		scaling.Rolled = 0
+	}
+	switch scaling.Step {
+	case infrav1.ControllerScalingStopping:
+		stopped, err := r.stopNodes(ctx, obj, controllerRole, scaling.To, scaling.From)
+		if err != nil {
+			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+				Type:               conditionType,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: obj.Generation,
+				Reason:             "ControllerScaleStopping",
+				Message:            fmt.Sprintf("Failed to stop the controllers for the custom resource (%s): (%s)", obj.Name, err),
+			})
+			log.Error(err, "Failed to stop the controllers for the custom resource", "name", obj.Name, "role", controllerRole)
+			return ctrl.Result{}, err
+		}
+		if !stopped {
+			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+				Type:               conditionType,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: obj.Generation,
+				Reason:             "ControllerScaleStopping",
+				Message:            fmt.Sprintf("Scaling the controllers from %d to %d: waiting for the removed controllers to stop", scaling.From, scaling.To),
+			})
+			// the kept controllers are not restarted until the removed ones are stopped
+			return ctrl.Result{RequeueAfter: scalingRequeueInterval}, nil
+		}
+		for i := scaling.To; i < scaling.From; i++ {
+			r.deleteController(ctx, obj, i)
+		}
+		scaling.Step = infrav1.ControllerScalingRolling
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.Generation,
+			Reason:             "ControllerScaleRolling",
+			Message:            fmt.Sprintf("Scaling the controllers from %d to %d: restarting the controllers with the new voters", scaling.From, scaling.To),
+		})
+		return ctrl.Result{RequeueAfter: scalingRequeueInterval}, nil
+	case infrav1.ControllerScalingRolling:
+		// the controllers and the brokers are not synced by the other steps until the kept controllers are rolled
+		for ; scaling.Rolled < min(scaling.From, scaling.To); scaling.Rolled++ {
+			index := scaling.Rolled
+			rolled, err := r.rollController(ctx, obj, index)
+			if err != nil {
+				meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+					Type:               conditionType,
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: obj.Generation,
+					Reason:             "ControllerScaleRolling",
+					Message:            fmt.Sprintf("Failed to restart the controller %d for the custom resource (%s): (%s)", index, obj.Name, err),
+				})
+				log.Error(err, "Failed to restart the controller for the custom resource", "name", obj.Name, "index", index)
+				return ctrl.Result{}, err
+			}
+			if !rolled {
+				meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+					Type:               conditionType,
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: obj.Generation,
+					Reason:             "ControllerScaleRolling",
+					Message:            fmt.Sprintf("Scaling the controllers from %d to %d: waiting for the controller %d to be ready with the new voters", scaling.From, scaling.To, index),
+				})
+				return ctrl.Result{RequeueAfter: scalingRequeueInterval}, nil
+			}
+			log.Info("the controller is restarted with the new voters", "index", index)
+		}
+		scaling.Step = infrav1.ControllerScalingStarting
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.Generation,
+			Reason:             "ControllerScaleStarting",
+			Message:            fmt.Sprintf("Scaling the controllers from %d to %d: starting the controllers with the new voters", scaling.From, scaling.To),
+		})
+		return ctrl.Result{}, nil
+	case infrav1.ControllerScalingStarting:
+		readyNum, err := getPodRunningNum(ctx, r.Client, obj.Namespace, getAutoMQLabelMap(obj.GetName(), controllerRole))
+		if err != nil {
+			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+				Type:               conditionType,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: obj.Generation,
+				Reason:             "ControllerScaleStarting",
+				Message:            fmt.Sprintf("Failed to get the controller pods for the custom resource (%s): (%s)", obj.Name, err),
+			})
+			log.Error(err, "Failed to get the controller pods for the custom resource", "name", obj.Name, "role", controllerRole)
+			return ctrl.Result{}, err
 		}
+		if int32(readyNum) < scaling.To {
+			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+				Type:               conditionType,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: obj.Generation,
+				Reason:             "ControllerScaleStarting",
+				Message:            fmt.Sprintf("Scaling the controllers from %d to %d: %d controllers are ready", scaling.From, scaling.To, readyNum),
+			})
+			return ctrl.Result{}, nil
+		}
+		log.Info("finish scaling the controllers", "from", scaling.From, "to", scaling.To)
+		r.Recorder.Eventf(obj, v1.EventTypeNormal, "ScaledControllers", "Scaled the controllers from %d to %d", scaling.From, scaling.To)
+		obj.Status.ControllerScaling = nil
 	}
 	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
 		Type:               conditionType,
@@ -86,10 +236,25 @@ func (r *AutoMQReconciler) syncControllersScale(ctx context.Context, obj *infrav
 		Reason:             "ControllerScaleReconciling",
 		Message:            fmt.Sprintf("Controller scale for the custom resource (%s) has been reconciled", obj.Name),
 	})
-	return true
+	return ctrl.Result{}, nil
 }
 
-func (r *AutoMQReconciler) syncControllers(ctx context.Context, obj *infrav1beta1.AutoMQ) bool {
+func (r *AutoMQReconciler) deleteController(ctx context.Context, obj *infrav1.AutoMQ, index int32) {
+	deploy := &appsv1.Deployment{}
+	deploy.Namespace = obj.Namespace
+	deploy.Name = getAutoMQName(controllerRole, &index)
+	_ = r.Client.Delete(ctx, deploy)
+	svc := &v1.Service{}
+	svc.Namespace = obj.Namespace
+	svc.Name = getAutoMQName(controllerRole, &index)
+	_ = r.Client.Delete(ctx, svc)
+	pvc := &v1.PersistentVolumeClaim{}
+	pvc.Namespace = obj.Namespace
+	pvc.Name = getAutoMQName(controllerRole, &index)
+	_ = r.Client.Delete(ctx, pvc)
+}
+
+func (r *AutoMQReconciler) syncControllers(ctx context.Context, obj *infrav1.AutoMQ) (ctrl.Result, error) {
 	conditionType := "SyncControllerReady"
 	log := log.FromContext(ctx)
 	// 1. sync pvc
@@ -97,7 +262,7 @@ func (r *AutoMQReconciler) syncControllers(ctx context.Context, obj *infrav1beta
 	// 3. sync svc
 	// 3. sync monitor
 
-	for i := 0; i < int(obj.Spec.Controller.Replicas); i++ {
+	for i := 0; i < int(controllerReplicas(obj)); i++ {
 		if err := r.syncControllerPVC(ctx, obj, int32(i)); err != nil {
 			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
 				Type:               conditionType,
@@ -107,7 +272,7 @@ func (r *AutoMQReconciler) syncControllers(ctx context.Context, obj *infrav1beta
 				Message:            fmt.Sprintf("Failed to create pvc for the custom resource (%s): (%s)", obj.Name, err),
 			})
 			log.Error(err, "Failed to create pvc for the custom resource (%s)", obj.Name, "role", controllerRole)
-			return true
+			return ctrl.Result{}, err
 		}
 		if err := r.syncControllerDeploy(ctx, obj, int32(i)); err != nil {
 			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
@@ -118,7 +283,7 @@ func (r *AutoMQReconciler) syncControllers(ctx context.Context, obj *infrav1beta
 				Message:            fmt.Sprintf("Failed to create deploy for the custom resource (%s): (%s)", obj.Name, err),
 			})
 			log.Error(err, "Failed to create deploy for the custom resource (%s)", obj.Name, "role", controllerRole)
-			return true
+			return ctrl.Result{}, err
 		}
 		if err := r.syncControllerService(ctx, obj, int32(i)); err != nil {
 			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
@@ -129,7 +294,7 @@ func (r *AutoMQReconciler) syncControllers(ctx context.Context, obj *infrav1beta
 				Message:            fmt.Sprintf("Failed to create service for the custom resource (%s): (%s)", obj.Name, err),
 			})
 			log.Error(err, "Failed to create service for the custom resource (%s)", obj.Name, "role", controllerRole)
-			return true
+			return ctrl.Result{}, err
 		}
 	}
 	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
@@ -139,10 +304,10 @@ func (r *AutoMQReconciler) syncControllers(ctx context.Context, obj *infrav1beta
 		Reason:             "ControllerReconciling",
 		Message:            fmt.Sprintf("Controller resource for the custom resource (%s) has been created or update", obj.Name),
 	})
-	return true
+	return ctrl.Result{}, nil
 }
 
-func (r *AutoMQReconciler) syncControllerPVC(ctx context.Context, obj *infrav1beta1.AutoMQ, index int32) error {
+func (r *AutoMQReconciler) syncControllerPVC(ctx context.Context, obj *infrav1.AutoMQ, index int32) error {
 	storage, _ := resource.ParseQuantity("100Gi")
 	pvc := &v1.PersistentVolumeClaim{}
 	pvc.Namespace = obj.Namespace
@@ -170,16 +335,55 @@ func (r *AutoMQReconciler) syncControllerPVC(ctx context.Context, obj *infrav1be
 	return nil
 }
 
-func (r *AutoMQReconciler) controllerVoters(obj *infrav1beta1.AutoMQ) []string {
+func (r *AutoMQReconciler) controllerVoters(obj *infrav1.AutoMQ) []string {
 	var voters []string
-	for i := 0; i < int(obj.Spec.Controller.Replicas); i++ {
-		index := int32(i)
-		voters = append(voters, fmt.Sprintf("%d@%s.%s.svc:%d", i, getAutoMQName(controllerRole, &index), obj.Namespace, 9093))
+	// in combined mode the broker nodes are the voters
+	if obj.IsCombined() {
+		for i := 0; i < int(obj.Spec.Broker.Replicas); i++ {
+			index := int32(i)
+			voters = append(voters, fmt.Sprintf("%d@%s.%s.svc:%d", i, getAutoMQName(brokerRole, &index), obj.Namespace, 9093))
+		}
+		return voters
+	}
+	return controllerVotersOf(obj, obj.Spec.Controller.Replicas)
+}
+
+func controllerVotersOf(obj *infrav1.AutoMQ, replicas int32) []string {
+	var voters []string
+	for i := int32(0); i < replicas; i++ {
+		voters = append(voters, fmt.Sprintf("%d@%s.%s.svc:%d", i, getAutoMQName(controllerRole, &i), obj.Namespace, 9093))
 	}
 	return voters
 }
 
-func (r *AutoMQReconciler) syncControllerDeploy(ctx context.Context, obj *infrav1beta1.AutoMQ, index int32) error {
+// controllerDeployVoters returns the voters of the controller, the kept controllers keep the voters before the
+// scaling until they are restarted one by one.
+func (r *AutoMQReconciler) controllerDeployVoters(obj *infrav1.AutoMQ, index int32) []string {
+	scaling := obj.Status.ControllerScaling
+	if scaling == nil || scaling.Step == infrav1.ControllerScalingStarting || index >= min(scaling.From, scaling.To) {
+		return r.controllerVoters(obj)
+	}
+	if scaling.Step == infrav1.ControllerScalingRolling && index <= scaling.Rolled {
+		return r.controllerVoters(obj)
+	}
+	return controllerVotersOf(obj, scaling.From)
+}
+
+// rollController updates the controller with the index to the new voters, and reports whether its pod is ready.
+func (r *AutoMQReconciler) rollController(ctx context.Context, obj *infrav1.AutoMQ, index int32) (bool, error) {
+	if err := r.syncControllerDeploy(ctx, obj, index); err != nil {
+		return false, err
+	}
+	deploy := &appsv1.Deployment{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: obj.Namespace, Name: getAutoMQName(controllerRole, &index)}, deploy); err != nil {
+		return false, err
+	}
+	replicas := ptr.Deref(deploy.Spec.Replicas, 1)
+	return deploy.Status.ObservedGeneration >= deploy.Generation && deploy.Status.Replicas == replicas &&
+		deploy.Status.UpdatedReplicas == replicas && deploy.Status.ReadyReplicas == replicas, nil
+}
+
+func (r *AutoMQReconciler) syncControllerDeploy(ctx context.Context, obj *infrav1.AutoMQ, index int32) error {
 	deploy := &appsv1.Deployment{}
 	deploy.Namespace = obj.Namespace
 	deploy.Name = getAutoMQName(controllerRole, &index)
@@ -188,7 +392,6 @@ func (r *AutoMQReconciler) syncControllerDeploy(ctx context.Context, obj *infrav
 	deploy.Spec.Selector = &metav1.LabelSelector{
 		MatchLabels: labelMap,
 	}
-	sysctl := sysctlContainer()
 	envs := []v1.EnvVar{
 		{
 			Name: "NAMESPACE_NAME",
@@ -214,19 +417,15 @@ func (r *AutoMQReconciler) syncControllerDeploy(ctx context.Context, obj *infrav
 				},
 			},
 		},
-		{
-			Name:  "KAFKA_S3_ACCESS_KEY",
-			Value: obj.Spec.S3.AccessKeyID,
-		},
-		{
-			Name:  "KAFKA_S3_SECRET_KEY",
-			Value: obj.Spec.S3.SecretAccessKey,
-		},
+	}
+	envs = append(envs, s3CredentialEnvs(obj)...)
+	envs = append(envs, []v1.EnvVar{
 		{
 			Name:  "KAFKA_HEAP_OPTS",
 			Value: strings.Join(obj.Spec.Controller.JVMOptions, " "),
 		},
-	}
+	}...)
+	envs = append(envs, autoBalancerEnvs(obj, controllerRole)...)
 	cmds := []string{
 		"/opt/kafka/scripts/mq-start.sh",
 		"up",
@@ -237,7 +436,7 @@ func (r *AutoMQReconciler) syncControllerDeploy(ctx context.Context, obj *infrav
 		"--cluster.id",
 		obj.Spec.ClusterID,
 		"--controller.quorum.voters",
-		strings.Join(r.controllerVoters(obj), ","),
+		strings.Join(r.controllerDeployVoters(obj, index), ","),
 		"--s3.bucket",
 		obj.Spec.S3.Bucket,
 		"--s3.endpoint",
@@ -248,18 +447,19 @@ func (r *AutoMQReconciler) syncControllerDeploy(ctx context.Context, obj *infrav
 		fmt.Sprintf("%t", obj.Spec.S3.EnablePathStyle),
 	}
 	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		_, err := controllerutil.CreateOrUpdate(ctx, r.Client, deploy, func() error {
+		var generation int64
+		change, err := controllerutil.CreateOrUpdate(ctx, r.Client, deploy, func() error {
+			generation = deploy.Generation
 			deploy.Labels = getAutoMQLabelMap(obj.GetName(), controllerRole)
+			deploy.Spec.Replicas = aws.Int32(1)
 			deploy.Spec.Strategy = appsv1.DeploymentStrategy{
 				Type: appsv1.RollingUpdateDeploymentStrategyType,
 			}
 			deploy.Spec.Template.Labels = labelMap
 			deploy.Spec.Template.Spec.HostNetwork = false
 			deploy.Spec.Template.Spec.TerminationGracePeriodSeconds = aws.Int64(60 * 2)
-			deploy.Spec.Template.Spec.InitContainers = []v1.Container{
-				sysctl,
-			}
-			deploy.Spec.Template.Spec.Affinity = obj.Spec.Controller.Affinity.ToK8sAffinity()
+			deploy.Spec.Template.Spec.InitContainers = initContainers(obj)
+			deploy.Spec.Template.Spec.Affinity = obj.Spec.Controller.Affinity.ToK8sAffinity(getAutoMQLabelMap(obj.GetName(), controllerRole))
 			deploy.Spec.Template.Spec.Volumes = []v1.Volume{
 				{
 					Name: "script",
@@ -354,7 +554,7 @@ func (r *AutoMQReconciler) syncControllerDeploy(ctx context.Context, obj *infrav
 				deploy.Spec.Template.Annotations["prometheus.io/port"] = "9090"
 				deploy.Spec.Template.Annotations["prometheus.io/path"] = "/metrics"
 			}
-			if r.MountTZ {
+			if r.MountTZ && !obj.Spec.Security.Restricted {
 				deploy.Spec.Template.Spec.Volumes = append(deploy.Spec.Template.Spec.Volumes, v1.Volume{
 					Name: "k8tz",
 					VolumeSource: v1.VolumeSource{
@@ -368,24 +568,31 @@ func (r *AutoMQReconciler) syncControllerDeploy(ctx context.Context, obj *infrav
 					MountPath: "/etc/localtime",
 				})
 			}
-			if obj.Spec.Controller.Resource.Requests != nil {
-				deploy.Spec.Template.Spec.Containers[0].Resources.Requests = obj.Spec.Controller.Resource.Requests
+			if obj.Spec.Controller.Resources.Requests != nil {
+				deploy.Spec.Template.Spec.Containers[0].Resources.Requests = obj.Spec.Controller.Resources.Requests
 			}
-			if obj.Spec.Controller.Resource.Limits != nil {
-				deploy.Spec.Template.Spec.Containers[0].Resources.Limits = obj.Spec.Controller.Resource.Limits
+			if obj.Spec.Controller.Resources.Limits != nil {
+				deploy.Spec.Template.Spec.Containers[0].Resources.Limits = obj.Spec.Controller.Resources.Limits
 			}
-			if obj.Spec.Controller.Envs != nil && len(obj.Spec.Controller.Envs) > 0 {
-				deploy.Spec.Template.Spec.Containers[0].Env = append(deploy.Spec.Template.Spec.Containers[0].Env, obj.Spec.Controller.Envs...)
+			if obj.Spec.Controller.Env != nil && len(obj.Spec.Controller.Env) > 0 {
+				deploy.Spec.Template.Spec.Containers[0].Env = append(deploy.Spec.Template.Spec.Containers[0].Env, obj.Spec.Controller.Env...)
 			}
+			deploy.Spec.Template.Spec.SecurityContext = &v1.PodSecurityContext{}
+			applyPodSysctls(&deploy.Spec.Template.Spec, obj.Spec.Sysctl)
+			applyRestrictedSecurity(&deploy.Spec.Template.Spec, obj)
+			applyPodTemplate(&deploy.Spec.Template, obj.Spec.Controller.PodTemplate)
 			return nil
 		})
+		if err == nil && change == controllerutil.OperationResultUpdated && deploy.Generation != generation {
+			r.Recorder.Eventf(obj, v1.EventTypeNormal, "RollingUpdate", "The deployment %s is updated to generation %d, the pod is restarted", deploy.Name, deploy.Generation)
+		}
 		return err
 	}); err != nil {
 		return err
 	}
 	return nil
 }
-func (r *AutoMQReconciler) syncControllerService(ctx context.Context, obj *infrav1beta1.AutoMQ, index int32) error {
+func (r *AutoMQReconciler) syncControllerService(ctx context.Context, obj *infrav1.AutoMQ, index int32) error {
 	svc := &v1.Service{}
 	svc.Namespace = obj.Namespace
 	svc.Name = getAutoMQName(controllerRole, &index)
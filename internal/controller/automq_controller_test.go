@@ -0,0 +1,248 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	"github.com/cuisongliu/automq-operator/internal/pkg/storage"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// brokerDeployNodeID returns the node id in the command of the generated broker deployment.
+func brokerDeployNodeID(t *testing.T, r *AutoMQReconciler, obj *infrav1.AutoMQ, index int32) string {
+	t.Helper()
+	ctx := context.Background()
+	if err := r.syncBrokerDeploy(ctx, obj, index); err != nil {
+		t.Fatal(err)
+	}
+	deploy := &appsv1.Deployment{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: obj.Namespace, Name: getAutoMQName(brokerRole, &index)}, deploy); err != nil {
+		t.Fatal(err)
+	}
+	args := strings.Split(deploy.Spec.Template.Spec.Containers[0].Command[2], " \\\n")
+	for i, arg := range args {
+		if arg == "--node.id" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	t.Fatalf("the command of the broker %d has no node id: %v", index, args)
+	return ""
+}
+
+func TestBrokerNodeIDAcrossControllerScale(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+	obj := &infrav1.AutoMQ{ObjectMeta: metav1.ObjectMeta{Name: "automq", Namespace: "default"}}
+	obj.Spec.Controller.Replicas = 1
+	obj.Spec.Broker.Replicas = 2
+	var services []client.Object
+	for i := int32(0); i < obj.Spec.Broker.Replicas; i++ {
+		services = append(services, &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: getAutoMQName(brokerRole, &i), Namespace: "default"},
+			Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Name: brokerRole, Port: 9092}}},
+		})
+	}
+	r := &AutoMQReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(services...).Build(),
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+	}
+
+	// the AutoMQ is created with 1 controller and scaled to 3 controllers
+	initBrokerIDOffset(obj)
+	obj.Status.ControllerReplicas = 1
+	obj.Status.BrokerReplicas = 2
+	want := []string{"1000", "1001"}
+	for i, id := range want {
+		if got := brokerDeployNodeID(t, r, obj, int32(i)); got != id {
+			t.Errorf("the node id of the broker %d = %s, want %s", i, got, id)
+		}
+	}
+	obj.Spec.Controller.Replicas = 3
+	initBrokerIDOffset(obj)
+	for i, id := range want {
+		if got := brokerDeployNodeID(t, r, obj, int32(i)); got != id {
+			t.Errorf("the node id of the broker %d after scaling the controllers = %s, want %s", i, got, id)
+		}
+	}
+
+	// the AutoMQ created before the offset was saved keeps the node ids after its running controller
+	legacy := obj.DeepCopy()
+	legacy.Status.BrokerIDOffset = nil
+	initBrokerIDOffset(legacy)
+	if got := brokerDeployNodeID(t, r, legacy, 0); got != "1" {
+		t.Errorf("the node id of the broker 0 of the existing AutoMQ = %s, want 1", got)
+	}
+	// and its controllers can not be scaled over the node ids of the brokers
+	_, err := r.syncControllersScale(context.Background(), legacy)
+	if !errors.Is(err, reconcile.TerminalError(nil)) {
+		t.Errorf("syncControllersScale() error = %v, want terminal error", err)
+	}
+	if condition := meta.FindStatusCondition(legacy.Status.Conditions, "SyncControllerScale"); condition == nil || condition.Reason != "ControllerScaleNodeID" {
+		t.Errorf("the SyncControllerScale condition = %+v, want ControllerScaleNodeID", condition)
+	}
+
+	combined := &infrav1.AutoMQ{}
+	combined.Spec.Mode = infrav1.AutoMQModeCombined
+	initBrokerIDOffset(combined)
+	if got := brokerNodeID(combined, 2); got != 2 {
+		t.Errorf("the node id of the server 2 in combined mode = %d, want 2 like the voters", got)
+	}
+}
+
+// existingBucket succeeds to create the existing bucket like some S3 services.
+type existingBucket struct {
+	storage.Bucket
+	buckets map[string]bool
+}
+
+func (b *existingBucket) BucketExists(_ context.Context, bucketName string) (bool, error) {
+	return b.buckets[bucketName], nil
+}
+
+func (b *existingBucket) MkBucket(_ context.Context, bucketName string) error {
+	b.buckets[bucketName] = true
+	return nil
+}
+
+func TestEnsureBucket(t *testing.T) {
+	ctx := context.Background()
+	bucket := &existingBucket{buckets: map[string]bool{}}
+	created, err := ensureBucket(ctx, bucket, "automq")
+	if err != nil || !created {
+		t.Fatalf("ensureBucket() = %v, %v, want the bucket created", created, err)
+	}
+	if created, err = ensureBucket(ctx, bucket, "automq"); err != nil || created {
+		t.Errorf("ensureBucket() of the existing bucket = %v, %v, want not created", created, err)
+	}
+}
+
+// controllerDeployVotersArg returns the voters in the command of the controller deployment.
+func controllerDeployVotersArg(t *testing.T, r *AutoMQReconciler, obj *infrav1.AutoMQ, index int32) string {
+	t.Helper()
+	deploy := &appsv1.Deployment{}
+	if err := r.Get(context.Background(), client.ObjectKey{Namespace: obj.Namespace, Name: getAutoMQName(controllerRole, &index)}, deploy); err != nil {
+		t.Fatal(err)
+	}
+	args := strings.Split(deploy.Spec.Template.Spec.Containers[0].Command[2], " \\\n")
+	for i, arg := range args {
+		if arg == "--controller.quorum.voters" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	t.Fatalf("the command of the controller %d has no voters: %v", index, args)
+	return ""
+}
+
+// readyController marks the deployment of the controller as rolled out.
+func readyController(t *testing.T, r *AutoMQReconciler, obj *infrav1.AutoMQ, index int32) {
+	t.Helper()
+	ctx := context.Background()
+	deploy := &appsv1.Deployment{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: obj.Namespace, Name: getAutoMQName(controllerRole, &index)}, deploy); err != nil {
+		t.Fatal(err)
+	}
+	deploy.Status = appsv1.DeploymentStatus{ObservedGeneration: deploy.Generation, Replicas: 1, UpdatedReplicas: 1, ReadyReplicas: 1}
+	if err := r.Status().Update(ctx, deploy); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestControllerScaleRollsOneByOne(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+	obj := &infrav1.AutoMQ{ObjectMeta: metav1.ObjectMeta{Name: "automq", Namespace: "default"}}
+	obj.Spec.Controller.Replicas = 3
+	obj.Spec.Broker.Replicas = 1
+	r := &AutoMQReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&appsv1.Deployment{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				// the changed deployment is not rolled out until its new generation is observed
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					obj.SetGeneration(obj.GetGeneration() + 1)
+					return c.Update(ctx, obj, opts...)
+				},
+			}).Build(),
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+	}
+	initBrokerIDOffset(obj)
+	for i := int32(0); i < 3; i++ {
+		if err := r.syncControllerDeploy(ctx, obj, i); err != nil {
+			t.Fatal(err)
+		}
+		readyController(t, r, obj, i)
+	}
+	obj.Status.ControllerReplicas = 3
+	oldVoters := strings.Join(controllerVotersOf(obj, 3), ",")
+	newVoters := strings.Join(controllerVotersOf(obj, 5), ",")
+
+	obj.Spec.Controller.Replicas = 5
+	if _, err := r.syncControllersScale(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	if scaling := obj.Status.ControllerScaling; scaling == nil || scaling.Step != infrav1.ControllerScalingRolling {
+		t.Fatalf("the controller scaling = %+v, want rolling", scaling)
+	}
+
+	// the controllers are restarted with the new voters one at a time
+	for i := int32(0); i < 3; i++ {
+		if _, err := r.syncControllersScale(ctx, obj); err != nil {
+			t.Fatal(err)
+		}
+		if got := obj.Status.ControllerScaling.Rolled; got != i {
+			t.Fatalf("the rolled controllers = %d, want %d", got, i)
+		}
+		for j := int32(0); j < 3; j++ {
+			want := oldVoters
+			if j <= i {
+				want = newVoters
+			}
+			if got := controllerDeployVotersArg(t, r, obj, j); got != want {
+				t.Errorf("the voters of the controller %d while rolling the controller %d = %s, want %s", j, i, got, want)
+			}
+		}
+		readyController(t, r, obj, i)
+	}
+	if _, err := r.syncControllersScale(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	if scaling := obj.Status.ControllerScaling; scaling == nil || scaling.Step != infrav1.ControllerScalingStarting {
+		t.Fatalf("the controller scaling = %+v, want starting the added controllers", scaling)
+	}
+	if got := r.controllerDeployVoters(obj, 4); strings.Join(got, ",") != newVoters {
+		t.Errorf("the voters of the added controller = %v, want %s", got, newVoters)
+	}
+}
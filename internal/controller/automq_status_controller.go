@@ -19,13 +19,17 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	infrav1beta1 "github.com/cuisongliu/automq-operator/api/v1beta1"
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -33,44 +37,159 @@ import (
 func (r *AutoMQReconciler) statusReconcile(ctx context.Context, obj client.Object) error {
 	log := log.FromContext(ctx)
 	log.V(1).Info("update reconcile status controller automq", "request", client.ObjectKeyFromObject(obj))
-	automq := &infrav1beta1.AutoMQ{}
+	automq := &infrav1.AutoMQ{}
 	err := r.Get(ctx, client.ObjectKeyFromObject(obj), automq)
 	if err != nil {
 		return client.IgnoreNotFound(err)
 	}
-	automq.Status.Phase = infrav1beta1.AutoMQPending
-	// Let's just set the status as Unknown when no status are available
-	status := true
-	for _, v := range automq.Status.Conditions {
-		if v.Status != metav1.ConditionTrue {
-			status = false
-			break
-		}
+	cLabelMap := getAutoMQLabelMap(obj.GetName(), controllerRole)
+	bLabelMap := getAutoMQLabelMap(obj.GetName(), brokerRole)
+	cRunningNum, err := getPodRunningNum(ctx, r.Client, automq.Namespace, cLabelMap)
+	if err != nil {
+		return err
+	}
+	bRunningNum, err := getPodRunningNum(ctx, r.Client, automq.Namespace, bLabelMap)
+	if err != nil {
+		return err
+	}
+	rolling, err := r.isRollingOut(ctx, automq)
+	if err != nil {
+		return err
+	}
+	automq.Status.ReadyPods = int32(cRunningNum) + int32(bRunningNum)
+	automq.Status.ReadyControllers = fmt.Sprintf("%d/%d", cRunningNum, controllerReplicas(automq))
+	automq.Status.ReadyBrokers = fmt.Sprintf("%d/%d", bRunningNum, automq.Spec.Broker.Replicas)
+	automq.Status.ReadyBrokerReplicas = int32(bRunningNum)
+	allReady := int32(cRunningNum) == controllerReplicas(automq) && int32(bRunningNum) == automq.Spec.Broker.Replicas
+
+	phase, message := automqPhase(automq, allReady, rolling)
+	automq.Status.Phase = phase
+	if phase == infrav1.AutoMQReady && automq.Status.FirstReadyTime == nil {
+		now := metav1.Now()
+		automq.Status.FirstReadyTime = &now
+	}
+	condition := metav1.Condition{
+		Type:               infrav1.ConditionReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: automq.Generation,
+		Reason:             string(phase),
+		Message:            message,
 	}
-	if !status {
-		automq.Status.Phase = infrav1beta1.AutoMQError
-	} else {
-		automq.Status.Phase = infrav1beta1.AutoMQInProcess
+	if phase == infrav1.AutoMQReady {
+		condition.Status = metav1.ConditionTrue
 	}
-	if automq.Status.Phase == infrav1beta1.AutoMQInProcess {
-		cLabelMap := getAutoMQLabelMap(obj.GetName(), controllerRole)
-		bLabelMap := getAutoMQLabelMap(obj.GetName(), brokerRole)
+	meta.SetStatusCondition(&automq.Status.Conditions, condition)
 
-		cRunningNum, err := getPodRunningNum(ctx, r.Client, automq.Namespace, cLabelMap)
-		if err != nil {
-			return err
+	if err = r.recordPodFailures(ctx, automq); err != nil {
+		return err
+	}
+	return r.syncStatus(ctx, automq)
+}
+
+// progressingReasons are the reasons of the false conditions waiting for the cluster or the environment, the AutoMQ is
+// creating or degraded with them instead of failed.
+var progressingReasons = map[string]bool{
+	"BrokerLoadBalancerPending": true,
+	"GatewayAPINotInstalled":    true,
+}
+
+// automqPhase returns the phase of the AutoMQ and the message of the Ready condition.
+func automqPhase(automq *infrav1.AutoMQ, allReady, rolling bool) (infrav1.AutoMQPhase, string) {
+	if !automq.GetDeletionTimestamp().IsZero() {
+		return infrav1.AutoMQDeleting, "The AutoMQ is being deleted"
+	}
+	if automq.IsPaused() {
+		return infrav1.AutoMQPaused, fmt.Sprintf("The reconciliation is paused by the annotation %s", infrav1.PausedAnnotation)
+	}
+	if scaling := automq.Status.ControllerScaling; scaling != nil {
+		return infrav1.AutoMQScaling, fmt.Sprintf("Scaling the controllers from %d to %d: %s", scaling.From, scaling.To, scaling.Step)
+	}
+	if scaling := automq.Status.BrokerScaling; scaling != nil {
+		return infrav1.AutoMQScaling, fmt.Sprintf("Scaling down the brokers from %d to %d: %s", scaling.From, scaling.To, scaling.Step)
+	}
+	var failed, progressing []string
+	for _, c := range automq.Status.Conditions {
+		if c.Type == infrav1.ConditionReady || c.Status == metav1.ConditionTrue {
+			continue
+		}
+		if progressingReasons[c.Reason] {
+			progressing = append(progressing, fmt.Sprintf("%s: %s", c.Type, c.Message))
+			continue
 		}
-		bRunningNum, err := getPodRunningNum(ctx, r.Client, automq.Namespace, bLabelMap)
-		if err != nil {
-			return err
+		failed = append(failed, fmt.Sprintf("%s: %s", c.Type, c.Message))
+	}
+	if len(failed) > 0 {
+		return infrav1.AutoMQError, strings.Join(failed, "; ")
+	}
+	// the cluster is created once it has been ready, it is degraded instead of creating afterwards
+	if automq.Status.FirstReadyTime == nil {
+		if allReady && automq.Status.ObservedGeneration == automq.Generation && len(progressing) == 0 {
+			return infrav1.AutoMQReady, readyMessage(automq)
+		}
+		if len(progressing) > 0 {
+			return infrav1.AutoMQCreating, "Creating the AutoMQ: " + strings.Join(progressing, "; ")
+		}
+		return infrav1.AutoMQCreating, fmt.Sprintf("Creating the AutoMQ: %s controllers and %s brokers are ready", automq.Status.ReadyControllers, automq.Status.ReadyBrokers)
+	}
+	if automq.Status.BrokerReplicas != automq.Spec.Broker.Replicas {
+		return infrav1.AutoMQScaling, fmt.Sprintf("Scaling the brokers from %d to %d", automq.Status.BrokerReplicas, automq.Spec.Broker.Replicas)
+	}
+	if automq.Status.ObservedGeneration != automq.Generation || rolling {
+		return infrav1.AutoMQUpgrading, fmt.Sprintf("Rolling out the changes: %s controllers and %s brokers are ready", automq.Status.ReadyControllers, automq.Status.ReadyBrokers)
+	}
+	if !allReady {
+		return infrav1.AutoMQDegraded, fmt.Sprintf("%s controllers and %s brokers are ready", automq.Status.ReadyControllers, automq.Status.ReadyBrokers)
+	}
+	if len(progressing) > 0 {
+		return infrav1.AutoMQDegraded, strings.Join(progressing, "; ")
+	}
+	return infrav1.AutoMQReady, readyMessage(automq)
+}
+
+func readyMessage(automq *infrav1.AutoMQ) string {
+	return fmt.Sprintf("All the %d controllers and %d brokers are ready", controllerReplicas(automq), automq.Spec.Broker.Replicas)
+}
+
+// isRollingOut reports whether any deployment of the AutoMQ is rolling out the new pod template.
+func (r *AutoMQReconciler) isRollingOut(ctx context.Context, automq *infrav1.AutoMQ) (bool, error) {
+	deploys := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deploys, client.InNamespace(automq.Namespace),
+		client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(getAutoMQLabelMap(automq.GetName(), ""))}); err != nil {
+		return false, fmt.Errorf("error listing deployments: %v", err)
+	}
+	for _, deploy := range deploys.Items {
+		if deploy.Status.ObservedGeneration < deploy.Generation {
+			return true, nil
 		}
-		automq.Status.ReadyPods = int32(cRunningNum) + int32(bRunningNum)
-		if int32(cRunningNum) == automq.Spec.Controller.Replicas && int32(bRunningNum) == automq.Spec.Broker.Replicas {
-			automq.Status.Phase = infrav1beta1.AutoMQReady
+		if deploy.Spec.Replicas != nil && deploy.Status.UpdatedReplicas < *deploy.Spec.Replicas {
+			return true, nil
 		}
 	}
+	return false, nil
+}
 
-	return r.syncStatus(ctx, automq)
+// podFailureGracePeriod is the time the started containers are allowed to be not ready.
+const podFailureGracePeriod = time.Minute
+
+// recordPodFailures records the warning events for the crash-looping containers and the containers failing the probes,
+// the events are deduplicated by the recorder.
+func (r *AutoMQReconciler) recordPodFailures(ctx context.Context, automq *infrav1.AutoMQ) error {
+	pods := &v1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(automq.Namespace),
+		client.MatchingLabelsSelector{Selector: labels.SelectorFromSet(getAutoMQLabelMap(automq.GetName(), ""))}); err != nil {
+		return fmt.Errorf("error listing pods: %v", err)
+	}
+	for _, pod := range pods.Items {
+		for _, cs := range pod.Status.ContainerStatuses {
+			switch {
+			case cs.State.Waiting != nil && cs.State.Waiting.Reason == "CrashLoopBackOff":
+				r.Recorder.Eventf(automq, v1.EventTypeWarning, "PodCrashLoopBackOff", "The container %s of pod %s is crash looping", cs.Name, pod.Name)
+			case cs.State.Running != nil && !cs.Ready && time.Since(cs.State.Running.StartedAt.Time) > podFailureGracePeriod:
+				r.Recorder.Eventf(automq, v1.EventTypeWarning, "ProbeFailed", "The container %s of pod %s is not ready, the probes are failing", cs.Name, pod.Name)
+			}
+		}
+	}
+	return nil
 }
 
 func getPodRunningNum(ctx context.Context, r client.Client, namespace string, labelsMap map[string]string) (int, error) {
@@ -100,3 +219,17 @@ func getPodRunningNum(ctx context.Context, r client.Client, namespace string, la
 	}
 	return runningPodsCount, nil
 }
+
+// getPodNum returns the number of the pods including the terminating ones.
+func getPodNum(ctx context.Context, r client.Client, namespace string, labelsMap map[string]string) (int, error) {
+	pods := &v1.PodList{}
+	labelSelector := labels.SelectorFromSet(labelsMap)
+	listOpts := []client.ListOption{
+		client.InNamespace(namespace),
+		client.MatchingLabelsSelector{Selector: labelSelector},
+	}
+	if err := r.List(ctx, pods, listOpts...); err != nil {
+		return 0, fmt.Errorf("error listing pods: %v", err)
+	}
+	return len(pods.Items), nil
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAutoMQPhase(t *testing.T) {
+	readyCondition := func(reason string) metav1.Condition {
+		status := metav1.ConditionFalse
+		if reason == string(infrav1.AutoMQReady) {
+			status = metav1.ConditionTrue
+		}
+		return metav1.Condition{Type: infrav1.ConditionReady, Status: status, Reason: reason}
+	}
+	now := metav1.Now()
+	tests := []struct {
+		name     string
+		mutate   func(obj *infrav1.AutoMQ)
+		allReady bool
+		rolling  bool
+		want     infrav1.AutoMQPhase
+	}{
+		{
+			name: "creating",
+			mutate: func(obj *infrav1.AutoMQ) {
+				obj.Status.ObservedGeneration = 0
+				obj.Status.FirstReadyTime = nil
+				obj.Status.Conditions = []metav1.Condition{readyCondition(string(infrav1.AutoMQCreating))}
+			},
+			allReady: true,
+			want:     infrav1.AutoMQCreating,
+		},
+		{
+			name:     "ready",
+			allReady: true,
+			want:     infrav1.AutoMQReady,
+		},
+		{
+			name: "first ready",
+			mutate: func(obj *infrav1.AutoMQ) {
+				obj.Status.FirstReadyTime = nil
+				obj.Status.Conditions = nil
+			},
+			allReady: true,
+			want:     infrav1.AutoMQReady,
+		},
+		{
+			name: "deleting",
+			mutate: func(obj *infrav1.AutoMQ) {
+				obj.DeletionTimestamp = &now
+			},
+			want: infrav1.AutoMQDeleting,
+		},
+		{
+			name: "scaling brokers",
+			mutate: func(obj *infrav1.AutoMQ) {
+				obj.Status.BrokerScaling = &infrav1.BrokerScalingStatus{From: 3, To: 1, Step: infrav1.BrokerScalingReassigning}
+			},
+			allReady: true,
+			want:     infrav1.AutoMQScaling,
+		},
+		{
+			name: "scaling up brokers",
+			mutate: func(obj *infrav1.AutoMQ) {
+				obj.Spec.Broker.Replicas = 5
+			},
+			want: infrav1.AutoMQScaling,
+		},
+		{
+			name: "error",
+			mutate: func(obj *infrav1.AutoMQ) {
+				obj.Status.Conditions = append(obj.Status.Conditions, metav1.Condition{Type: "SyncS3ServiceReady", Status: metav1.ConditionFalse})
+			},
+			allReady: true,
+			want:     infrav1.AutoMQError,
+		},
+		{
+			name: "creating with pending load balancer",
+			mutate: func(obj *infrav1.AutoMQ) {
+				obj.Status.FirstReadyTime = nil
+				obj.Status.Conditions = []metav1.Condition{
+					readyCondition(string(infrav1.AutoMQCreating)),
+					{Type: "SyncBrokerReady", Status: metav1.ConditionFalse, Reason: "BrokerLoadBalancerPending"},
+				}
+			},
+			want: infrav1.AutoMQCreating,
+		},
+		{
+			name: "degraded without gateway api",
+			mutate: func(obj *infrav1.AutoMQ) {
+				obj.Status.Conditions = append(obj.Status.Conditions, metav1.Condition{Type: "SyncTLSRouteReady", Status: metav1.ConditionFalse, Reason: "GatewayAPINotInstalled"})
+			},
+			allReady: true,
+			want:     infrav1.AutoMQDegraded,
+		},
+		{
+			name: "upgrading",
+			mutate: func(obj *infrav1.AutoMQ) {
+				obj.Generation = 3
+			},
+			allReady: true,
+			want:     infrav1.AutoMQUpgrading,
+		},
+		{
+			name:     "rolling",
+			allReady: true,
+			rolling:  true,
+			want:     infrav1.AutoMQUpgrading,
+		},
+		{
+			name: "degraded",
+			want: infrav1.AutoMQDegraded,
+		},
+		{
+			name: "degraded after a failed reconcile",
+			mutate: func(obj *infrav1.AutoMQ) {
+				obj.Status.Conditions = []metav1.Condition{readyCondition(string(infrav1.AutoMQError))}
+			},
+			want: infrav1.AutoMQDegraded,
+		},
+		{
+			name: "paused",
+			mutate: func(obj *infrav1.AutoMQ) {
+				obj.Annotations = map[string]string{infrav1.PausedAnnotation: "true"}
+				obj.Spec.Broker.Replicas = 5
+				obj.Status.Conditions = append(obj.Status.Conditions, metav1.Condition{Type: infrav1.ConditionPaused, Status: metav1.ConditionTrue})
+			},
+			allReady: true,
+			want:     infrav1.AutoMQPaused,
+		},
+		{
+			name: "not paused",
+			mutate: func(obj *infrav1.AutoMQ) {
+				obj.Annotations = map[string]string{infrav1.PausedAnnotation: "false"}
+			},
+			allReady: true,
+			want:     infrav1.AutoMQReady,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := &infrav1.AutoMQ{}
+			obj.Generation = 2
+			obj.Spec.Controller.Replicas = 1
+			obj.Spec.Broker.Replicas = 3
+			obj.Status.BrokerReplicas = 3
+			obj.Status.ObservedGeneration = 2
+			obj.Status.FirstReadyTime = &now
+			obj.Status.Conditions = []metav1.Condition{readyCondition(string(infrav1.AutoMQReady))}
+			if tt.mutate != nil {
+				tt.mutate(obj)
+			}
+			if got, _ := automqPhase(obj, tt.allReady, tt.rolling); got != tt.want {
+				t.Errorf("automqPhase() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
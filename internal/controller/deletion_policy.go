@@ -0,0 +1,152 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	"github.com/cuisongliu/automq-operator/internal/pkg/storage"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// deletesPVCs reports whether the PVCs are deleted with the AutoMQ, the AutoMQ created before the deletion policy
+// is added has no policy and deletes the PVCs as before.
+func deletesPVCs(obj *infrav1.AutoMQ) bool {
+	return obj.Spec.DeletionPolicy != infrav1.DeletionPolicyRetain
+}
+
+// purgeS3Data deletes the objects of the cluster from the S3 bucket with the DeleteAll deletion policy, and the bucket
+// when no other cluster keeps its objects in it. The bucket is purged after all the pods are gone, one page of the
+// objects for each reconcile, and the progress is reported in the status.
+func (r *AutoMQReconciler) purgeS3Data(ctx context.Context, obj *infrav1.AutoMQ) (ctrl.Result, error) {
+	if obj.Spec.DeletionPolicy != infrav1.DeletionPolicyDeleteAll {
+		return ctrl.Result{}, nil
+	}
+	conditionType := "PurgeS3Data"
+	log := log.FromContext(ctx)
+	// the stopping brokers may still upload the objects
+	podNum, err := getPodNum(ctx, r.Client, obj.Namespace, getAutoMQLabelMap(obj.GetName(), ""))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if podNum != 0 {
+		log.Info("waiting for the pods to be deleted before purging the S3 bucket", "name", obj.Name, "namespace", obj.Namespace, "pods", podNum)
+		return ctrl.Result{RequeueAfter: scalingRequeueInterval}, nil
+	}
+	sg, err := r.newBucket(ctx, obj)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create the S3 client: %w", err)
+	}
+	deleted, done, err := purgeBucketPage(ctx, sg, obj.Spec.S3.Bucket, clusterObjectNamespace(obj))
+	obj.Status.PurgedObjects += int64(deleted)
+	if err != nil {
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.Generation,
+			Reason:             "PurgeFailed",
+			Message:            fmt.Sprintf("Failed to purge the S3 bucket %s after %d objects are deleted: %s", obj.Spec.S3.Bucket, obj.Status.PurgedObjects, err),
+		})
+		_ = r.syncStatus(ctx, obj)
+		return ctrl.Result{}, err
+	}
+	if done {
+		r.Recorder.Eventf(obj, v1.EventTypeNormal, "S3Purged", "The %d objects of the cluster %s are deleted from the S3 bucket %s, the bucket is deleted when it is empty",
+			obj.Status.PurgedObjects, obj.Spec.ClusterID, obj.Spec.S3.Bucket)
+		return ctrl.Result{}, nil
+	}
+	log.Info("purging the S3 bucket", "name", obj.Name, "namespace", obj.Namespace, "bucket", obj.Spec.S3.Bucket, "deleted", obj.Status.PurgedObjects)
+	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: obj.Generation,
+		Reason:             "Purging",
+		Message:            fmt.Sprintf("Purging the S3 bucket %s, %d objects are deleted", obj.Spec.S3.Bucket, obj.Status.PurgedObjects),
+	})
+	if err = r.syncStatus(ctx, obj); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// clusterObjectNamespace returns the namespace of the object keys of the cluster, AutoMQ keys the objects of the
+// cluster by <hash prefix>/_kafka_<cluster id>/<object id>, so the clusters with different cluster ids share a bucket.
+func clusterObjectNamespace(obj *infrav1.AutoMQ) string {
+	return "_kafka_" + obj.Spec.ClusterID
+}
+
+func isClusterObject(key, namespace string) bool {
+	return strings.HasPrefix(key, namespace+"/") || strings.Contains(key, "/"+namespace+"/")
+}
+
+// purgeBucketPage deletes the objects of the cluster in the first page of ListObjects holding any of them, the deleted
+// objects are not listed again so the next call gets the next ones. The objects of the other clusters are kept, and
+// the bucket is deleted only when it is empty. done is true when no object of the cluster is left.
+func purgeBucketPage(ctx context.Context, bucket storage.Bucket, bucketName, namespace string) (deleted int, done bool, err error) {
+	var keys []string
+	marker := ""
+	for {
+		keys, err = bucket.ListObjectsAfter(ctx, bucketName, "", marker)
+		if err != nil {
+			if isNoSuchBucket(err) {
+				return 0, true, nil
+			}
+			return 0, false, err
+		}
+		if len(keys) == 0 {
+			break
+		}
+		for _, key := range keys {
+			if !isClusterObject(key, namespace) {
+				continue
+			}
+			if err = bucket.DeleteObject(ctx, bucketName, key); err != nil {
+				return deleted, false, fmt.Errorf("failed to delete the object %s: %w", key, err)
+			}
+			deleted++
+		}
+		if deleted != 0 {
+			return deleted, false, nil
+		}
+		marker = keys[len(keys)-1]
+	}
+	// the bucket shared with the other clusters is kept
+	keys, err = bucket.ListObjectsAfter(ctx, bucketName, "", "")
+	if err != nil {
+		if isNoSuchBucket(err) {
+			return 0, true, nil
+		}
+		return 0, false, err
+	}
+	if len(keys) == 0 {
+		if err = bucket.DeleteBucket(ctx, bucketName); err != nil && !isNoSuchBucket(err) {
+			return 0, false, err
+		}
+	}
+	return 0, true, nil
+}
+
+func isNoSuchBucket(err error) bool {
+	return strings.Contains(err.Error(), "NoSuchBucket")
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	"github.com/cuisongliu/automq-operator/internal/pkg/storage"
+)
+
+// fakeBucket lists at most pageSize objects like the S3 ListObjects.
+type fakeBucket struct {
+	storage.Bucket
+	pageSize int
+	objects  map[string]bool
+	deleted  bool
+}
+
+func (f *fakeBucket) ListObjectsAfter(_ context.Context, bucketName, _, marker string) ([]string, error) {
+	if f.deleted {
+		return nil, fmt.Errorf("api error NoSuchBucket: the bucket %s does not exist", bucketName)
+	}
+	var keys []string
+	for key := range f.objects {
+		if key > marker {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if len(keys) > f.pageSize {
+		keys = keys[:f.pageSize]
+	}
+	return keys, nil
+}
+
+func (f *fakeBucket) DeleteObject(_ context.Context, _, objectKey string) error {
+	delete(f.objects, objectKey)
+	return nil
+}
+
+func (f *fakeBucket) DeleteBucket(_ context.Context, bucketName string) error {
+	if len(f.objects) != 0 {
+		return fmt.Errorf("api error BucketNotEmpty: the bucket %s is not empty", bucketName)
+	}
+	f.deleted = true
+	return nil
+}
+
+func TestPurgeBucketPage(t *testing.T) {
+	bucket := &fakeBucket{pageSize: 2, objects: map[string]bool{}}
+	for i := 0; i < 5; i++ {
+		bucket.objects[fmt.Sprintf("%08x/_kafka_automq/%d", i, i)] = true
+	}
+	var pages []int
+	total := 0
+	for i := 0; i < 10; i++ {
+		deleted, done, err := purgeBucketPage(context.Background(), bucket, "automq", "_kafka_automq")
+		if err != nil {
+			t.Fatalf("purgeBucketPage() error = %v", err)
+		}
+		total += deleted
+		if done {
+			break
+		}
+		pages = append(pages, deleted)
+	}
+	if fmt.Sprint(pages) != "[2 2 1]" {
+		t.Errorf("purgeBucketPage() pages = %v, want [2 2 1]", pages)
+	}
+	if total != 5 || !bucket.deleted {
+		t.Errorf("purgeBucketPage() deleted %d objects and bucket deleted %v, want 5 and true", total, bucket.deleted)
+	}
+	if _, done, err := purgeBucketPage(context.Background(), bucket, "automq", "_kafka_automq"); err != nil || !done {
+		t.Errorf("purgeBucketPage() of the deleted bucket = %v, %v, want done", done, err)
+	}
+}
+
+func TestPurgeBucketPageSharedBucket(t *testing.T) {
+	bucket := &fakeBucket{pageSize: 2, objects: map[string]bool{}}
+	for i := 0; i < 4; i++ {
+		bucket.objects[fmt.Sprintf("%08x/_kafka_other/%d", i, i)] = true
+	}
+	// the objects of the cluster are listed after the pages of the other cluster
+	bucket.objects["ffffffff/_kafka_automq/1"] = true
+	total := 0
+	for i := 0; i < 10; i++ {
+		deleted, done, err := purgeBucketPage(context.Background(), bucket, "automq", "_kafka_automq")
+		if err != nil {
+			t.Fatalf("purgeBucketPage() error = %v", err)
+		}
+		total += deleted
+		if done {
+			break
+		}
+	}
+	if total != 1 || len(bucket.objects) != 4 || bucket.deleted {
+		t.Errorf("purgeBucketPage() deleted %d objects, kept %v and bucket deleted %v, want only the objects of the cluster deleted",
+			total, bucket.objects, bucket.deleted)
+	}
+}
+
+func TestDeletesPVCs(t *testing.T) {
+	for policy, want := range map[infrav1.DeletionPolicy]bool{
+		"":                               true,
+		infrav1.DeletionPolicyRetain:     false,
+		infrav1.DeletionPolicyDeletePVCs: true,
+		infrav1.DeletionPolicyDeleteAll:  true,
+	} {
+		obj := &infrav1.AutoMQ{}
+		obj.Spec.DeletionPolicy = policy
+		if got := deletesPVCs(obj); got != want {
+			t.Errorf("deletesPVCs(%q) = %v, want %v", policy, got, want)
+		}
+	}
+}
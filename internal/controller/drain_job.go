@@ -0,0 +1,169 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	"github.com/cuisongliu/automq-operator/defaults"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	drainRole = "drain"
+
+	drainActionReassign   = "reassign"
+	drainActionUnregister = "unregister"
+
+	// drainJobRetryInterval is the backoff before the failed drain job is created again for the first time
+	drainJobRetryInterval    = 30 * time.Second
+	drainJobMaxRetryInterval = 10 * time.Minute
+)
+
+type drainJobState int
+
+const (
+	drainJobRunning drainJobState = iota
+	drainJobSucceeded
+	drainJobFailed
+)
+
+func getDrainJobName(action string) string {
+	return getAutoMQName(brokerRole+"-"+action, nil)
+}
+
+// drainJobBackoff returns the backoff before the failed drain job is created again, it is doubled by the retries.
+func drainJobBackoff(retries int32) time.Duration {
+	backoff := drainJobRetryInterval
+	for i := int32(0); i < retries && backoff < drainJobMaxRetryInterval; i++ {
+		backoff *= 2
+	}
+	return min(backoff, drainJobMaxRetryInterval)
+}
+
+// syncDrainJob creates the job running the drain script with the action if it does not exist,
+// and returns the state of the job. The failed job is deleted after the backoff of the retries of
+// the scaling, so it is created again by the next reconcile.
+func (r *AutoMQReconciler) syncDrainJob(ctx context.Context, obj *infrav1.AutoMQ, action string, args ...string) (drainJobState, error) {
+	job := &batchv1.Job{}
+	job.Namespace = obj.Namespace
+	job.Name = getDrainJobName(action)
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(job), job)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return drainJobRunning, err
+	}
+	if apierrors.IsNotFound(err) {
+		job = drainJob(obj, action, args...)
+		if err = controllerutil.SetControllerReference(obj, job, r.Scheme); err != nil {
+			return drainJobRunning, err
+		}
+		return drainJobRunning, r.Client.Create(ctx, job)
+	}
+	if job.DeletionTimestamp != nil {
+		// the failed job is being deleted before it is created again
+		return drainJobRunning, nil
+	}
+	if job.Status.Succeeded > 0 {
+		return drainJobSucceeded, nil
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == v1.ConditionTrue {
+			scaling := obj.Status.BrokerScaling
+			if scaling == nil || time.Since(condition.LastTransitionTime.Time) < drainJobBackoff(scaling.DrainRetries) {
+				return drainJobFailed, nil
+			}
+			if err = r.Client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+				return drainJobFailed, err
+			}
+			scaling.DrainRetries++
+			r.Recorder.Eventf(obj, v1.EventTypeNormal, "DrainJobRetrying", "The failed drain job %s is created again, retry %d", job.Name, scaling.DrainRetries)
+			return drainJobRunning, nil
+		}
+	}
+	return drainJobRunning, nil
+}
+
+func (r *AutoMQReconciler) deleteDrainJob(ctx context.Context, obj *infrav1.AutoMQ, action string) {
+	job := &batchv1.Job{}
+	job.Namespace = obj.Namespace
+	job.Name = getDrainJobName(action)
+	_ = r.Client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+}
+
+func drainJob(obj *infrav1.AutoMQ, action string, args ...string) *batchv1.Job {
+	labelMap := getAutoMQLabelMap(obj.GetName(), drainRole)
+	bootstrap := fmt.Sprintf("%s.%s.svc:%d", getAutoMQName(brokerRole+"-bootstrap", nil), obj.Namespace, 9092)
+	job := &batchv1.Job{}
+	job.Namespace = obj.Namespace
+	job.Name = getDrainJobName(action)
+	job.Labels = labelMap
+	job.Spec.BackoffLimit = aws.Int32(3)
+	job.Spec.Template.Labels = labelMap
+	job.Spec.Template.Spec.RestartPolicy = v1.RestartPolicyNever
+	job.Spec.Template.Spec.Volumes = []v1.Volume{
+		{
+			Name: "script",
+			VolumeSource: v1.VolumeSource{
+				ConfigMap: &v1.ConfigMapVolumeSource{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: obj.Name,
+					},
+					DefaultMode: aws.Int32(0755),
+				},
+			},
+		},
+	}
+	job.Spec.Template.Spec.Containers = []v1.Container{
+		{
+			Name:  drainRole,
+			Image: defaults.DefaultImageName,
+			Command: []string{
+				"/bin/bash",
+				"-c",
+				strings.Join(append([]string{"/opt/kafka/scripts/drain.sh", action, bootstrap}, args...), " "),
+			},
+			VolumeMounts: []v1.VolumeMount{
+				{
+					Name:      "script",
+					MountPath: "/opt/kafka/scripts/drain.sh",
+					SubPath:   "drain.sh",
+				},
+			},
+			ImagePullPolicy: v1.PullIfNotPresent,
+		},
+	}
+	applyRestrictedSecurity(&job.Spec.Template.Spec, obj)
+	return job
+}
+
+func joinNodeIDs(ids []int32) string {
+	s := make([]string, 0, len(ids))
+	for _, id := range ids {
+		s = append(s, fmt.Sprintf("%d", id))
+	}
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDrainJobBackoff(t *testing.T) {
+	for retries, want := range map[int32]time.Duration{0: 30 * time.Second, 1: time.Minute, 3: 4 * time.Minute, 10: 10 * time.Minute} {
+		if got := drainJobBackoff(retries); got != want {
+			t.Errorf("drainJobBackoff(%d) = %s, want %s", retries, got, want)
+		}
+	}
+}
+
+func TestSyncDrainJobRetry(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+	obj := &infrav1.AutoMQ{ObjectMeta: metav1.ObjectMeta{Name: "automq", Namespace: "default", UID: "automq-uid"}}
+	obj.Status.BrokerScaling = &infrav1.BrokerScalingStatus{From: 3, To: 2, Step: infrav1.BrokerScalingReassigning}
+	r := &AutoMQReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+	}
+	if _, err := r.syncDrainJob(ctx, obj, drainActionReassign, "1002", "1000,1001"); err != nil {
+		t.Fatal(err)
+	}
+	job := &batchv1.Job{}
+	key := client.ObjectKey{Namespace: "default", Name: getDrainJobName(drainActionReassign)}
+	if err := r.Get(ctx, key, job); err != nil {
+		t.Fatal(err)
+	}
+	if owner := metav1.GetControllerOf(job); owner == nil || owner.UID != obj.UID {
+		t.Errorf("the owner of the drain job = %v, want the AutoMQ", owner)
+	}
+
+	// the failed job is kept until the backoff is elapsed
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: v1.ConditionTrue, LastTransitionTime: metav1.Now()}}
+	if err := r.Status().Update(ctx, job); err != nil {
+		t.Fatal(err)
+	}
+	if state, err := r.syncDrainJob(ctx, obj, drainActionReassign, "1002", "1000,1001"); err != nil || state != drainJobFailed {
+		t.Fatalf("syncDrainJob() of the failed job = %v, %v, want failed", state, err)
+	}
+
+	// and deleted to be created again after the backoff
+	job.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(-drainJobBackoff(0)))
+	if err := r.Status().Update(ctx, job); err != nil {
+		t.Fatal(err)
+	}
+	if state, err := r.syncDrainJob(ctx, obj, drainActionReassign, "1002", "1000,1001"); err != nil || state != drainJobRunning {
+		t.Fatalf("syncDrainJob() of the failed job after the backoff = %v, %v, want running", state, err)
+	}
+	if err := r.Get(ctx, key, job); !apierrors.IsNotFound(err) {
+		t.Errorf("the failed job is not deleted: %v", err)
+	}
+	if obj.Status.BrokerScaling.DrainRetries != 1 {
+		t.Errorf("the drain retries = %d, want 1", obj.Status.BrokerScaling.DrainRetries)
+	}
+	if _, err := r.syncDrainJob(ctx, obj, drainActionReassign, "1002", "1000,1001"); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Get(ctx, key, job); err != nil {
+		t.Errorf("the drain job is not created again: %v", err)
+	}
+}
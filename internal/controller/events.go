@@ -0,0 +1,90 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// defaultEventDedupInterval is the interval in which the same event of an object is recorded only once.
+const defaultEventDedupInterval = 10 * time.Minute
+
+// dedupRecorder drops the events with the same object, type, reason and message recorded within the interval,
+// so the events repeated by every reconcile, e.g. a crash-looping broker, do not flood the event stream.
+type dedupRecorder struct {
+	recorder record.EventRecorder
+	interval time.Duration
+	now      func() time.Time
+
+	mu   sync.Mutex
+	last map[string]time.Time
+}
+
+var _ record.EventRecorder = &dedupRecorder{}
+
+func newDedupRecorder(recorder record.EventRecorder, interval time.Duration) *dedupRecorder {
+	return &dedupRecorder{
+		recorder: recorder,
+		interval: interval,
+		now:      time.Now,
+		last:     make(map[string]time.Time),
+	}
+}
+
+func (d *dedupRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if d.allow(object, eventtype, reason, message) {
+		d.recorder.Event(object, eventtype, reason, message)
+	}
+}
+
+func (d *dedupRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	d.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (d *dedupRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if d.allow(object, eventtype, reason, message) {
+		d.recorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+	}
+}
+
+// allow reports whether the event is not recorded within the interval, and remembers it.
+func (d *dedupRecorder) allow(object runtime.Object, eventtype, reason, message string) bool {
+	key := eventtype + "/" + reason + "/" + message
+	if accessor, err := meta.Accessor(object); err == nil {
+		key = string(accessor.GetUID()) + "/" + accessor.GetNamespace() + "/" + accessor.GetName() + "/" + key
+	}
+	now := d.now()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for k, t := range d.last {
+		if now.Sub(t) >= d.interval {
+			delete(d.last, k)
+		}
+	}
+	if _, ok := d.last[key]; ok {
+		return false
+	}
+	d.last[key] = now
+	return true
+}
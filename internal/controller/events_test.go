@@ -0,0 +1,53 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestDedupRecorder(t *testing.T) {
+	fake := record.NewFakeRecorder(10)
+	recorder := newDedupRecorder(fake, time.Minute)
+	now := time.Now()
+	recorder.now = func() time.Time { return now }
+
+	obj := &infrav1.AutoMQ{}
+	obj.Name = "automq"
+	obj.UID = "uid"
+	other := obj.DeepCopy()
+	other.UID = "other"
+
+	recorder.Eventf(obj, v1.EventTypeWarning, "PodCrashLoopBackOff", "The container %s is crash looping", "broker")
+	recorder.Eventf(obj, v1.EventTypeWarning, "PodCrashLoopBackOff", "The container %s is crash looping", "broker")
+	recorder.Eventf(obj, v1.EventTypeWarning, "PodCrashLoopBackOff", "The container %s is crash looping", "controller")
+	recorder.Eventf(other, v1.EventTypeWarning, "PodCrashLoopBackOff", "The container %s is crash looping", "broker")
+	if got := len(fake.Events); got != 3 {
+		t.Fatalf("recorded %d events, want 3", got)
+	}
+
+	now = now.Add(time.Minute)
+	recorder.Eventf(obj, v1.EventTypeWarning, "PodCrashLoopBackOff", "The container %s is crash looping", "broker")
+	if got := len(fake.Events); got != 4 {
+		t.Fatalf("recorded %d events after the interval, want 4", got)
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"maps"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// externalDNSHostnameAnnotation is the annotation of the hostname registered by the external-dns
+	externalDNSHostnameAnnotation = "external-dns.alpha.kubernetes.io/hostname"
+	// loadBalancerAnnotationsAnnotation records the keys of the annotations applied by the LoadBalancer exposure,
+	// they are removed when they are dropped from the spec or the exposure is changed
+	loadBalancerAnnotationsAnnotation = "automq.cuisongliu.github.com/load-balancer-annotations"
+	// loadBalancerRequeueInterval is the interval to check the ingress of the pending LoadBalancer services
+	loadBalancerRequeueInterval = 10 * time.Second
+)
+
+// applyExposure sets the type and the load balancer configuration of the broker or the bootstrap service,
+// the hostname is annotated for the external-dns in LoadBalancer mode. The services are only routed in the
+// cluster in TLSRoute mode, the annotations of the LoadBalancer are removed out of LoadBalancer mode.
+func applyExposure(obj *infrav1.AutoMQ, svc *v1.Service, hostname string) {
+	if !obj.IsLoadBalancer() {
+		svc.Spec.Type = v1.ServiceTypeNodePort
+		if obj.IsTLSRoute() {
+			svc.Spec.Type = v1.ServiceTypeClusterIP
+		}
+		svc.Spec.LoadBalancerClass = nil
+		svc.Spec.LoadBalancerSourceRanges = nil
+		// the services applied before the keys are recorded drop the annotations still in the spec
+		if lb := obj.Spec.Exposure.LoadBalancer; lb != nil {
+			for key := range lb.Annotations {
+				delete(svc.Annotations, key)
+			}
+		}
+		applyLoadBalancerAnnotations(svc, nil)
+		delete(svc.Annotations, externalDNSHostnameAnnotation)
+		return
+	}
+	lb := obj.Spec.Exposure.LoadBalancer
+	if lb == nil {
+		lb = &infrav1.LoadBalancerSpec{}
+	}
+	// the class is immutable, it is only set when the service becomes a LoadBalancer
+	if svc.Spec.Type != v1.ServiceTypeLoadBalancer {
+		svc.Spec.LoadBalancerClass = lb.LoadBalancerClass
+	}
+	svc.Spec.Type = v1.ServiceTypeLoadBalancer
+	svc.Spec.LoadBalancerSourceRanges = lb.LoadBalancerSourceRanges
+	annotations := maps.Clone(lb.Annotations)
+	if hostname != "" {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[externalDNSHostnameAnnotation] = hostname
+	}
+	applyLoadBalancerAnnotations(svc, annotations)
+	if hostname == "" {
+		delete(svc.Annotations, externalDNSHostnameAnnotation)
+	}
+}
+
+// applyLoadBalancerAnnotations replaces the annotations applied by the previous LoadBalancer exposure with the
+// desired ones, the other annotations of the service are kept.
+func applyLoadBalancerAnnotations(svc *v1.Service, annotations map[string]string) {
+	if applied := svc.Annotations[loadBalancerAnnotationsAnnotation]; applied != "" {
+		for _, key := range strings.Split(applied, ",") {
+			if _, ok := annotations[key]; !ok {
+				delete(svc.Annotations, key)
+			}
+		}
+	}
+	if len(annotations) == 0 {
+		delete(svc.Annotations, loadBalancerAnnotationsAnnotation)
+		return
+	}
+	if svc.Annotations == nil {
+		svc.Annotations = map[string]string{}
+	}
+	maps.Copy(svc.Annotations, annotations)
+	svc.Annotations[loadBalancerAnnotationsAnnotation] = strings.Join(slices.Sorted(maps.Keys(annotations)), ",")
+}
+
+// brokerHostname returns the hostname of the broker of the LoadBalancer or the TLSRoute exposure.
+func brokerHostname(obj *infrav1.AutoMQ, index int32) string {
+	template := ""
+	switch exposure := obj.Spec.Exposure; {
+	case obj.IsLoadBalancer() && exposure.LoadBalancer != nil:
+		template = exposure.LoadBalancer.HostnameTemplate
+	case obj.IsTLSRoute() && exposure.TLSRoute != nil:
+		template = exposure.TLSRoute.HostnameTemplate
+	}
+	return infrav1.RenderHostname(template, obj.Name, obj.Namespace, index)
+}
+
+// bootstrapHostname returns the hostname of the bootstrap of the LoadBalancer or the TLSRoute exposure.
+func bootstrapHostname(obj *infrav1.AutoMQ) string {
+	template := ""
+	switch exposure := obj.Spec.Exposure; {
+	case obj.IsLoadBalancer() && exposure.LoadBalancer != nil:
+		template = exposure.LoadBalancer.BootstrapHostname
+	case obj.IsTLSRoute() && exposure.TLSRoute != nil:
+		template = exposure.TLSRoute.BootstrapHostname
+	}
+	return infrav1.RenderHostname(template, obj.Name, obj.Namespace, 0)
+}
+
+// bootstrapExternalAddress returns the address of the bootstrap out of the cluster, it is empty in NodePort mode
+// and until the ingress of the bootstrap LoadBalancer service is assigned.
+func bootstrapExternalAddress(obj *infrav1.AutoMQ, svc *v1.Service) string {
+	switch {
+	case obj.IsLoadBalancer():
+		if address, ok := loadBalancerAddress(svc, bootstrapHostname(obj)); ok {
+			return net.JoinHostPort(address, "9092")
+		}
+	case obj.IsTLSRoute() && obj.Spec.Exposure.TLSRoute != nil:
+		return net.JoinHostPort(bootstrapHostname(obj), strconv.Itoa(int(tlsRoutePort(obj))))
+	}
+	return ""
+}
+
+// loadBalancerAddress returns the address advertised for the LoadBalancer service, the hostname of the
+// external-dns is preferred to the ingress IP or hostname. It is not found until the ingress is assigned.
+func loadBalancerAddress(svc *v1.Service, hostname string) (string, bool) {
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		address := ingress.IP
+		if address == "" {
+			address = ingress.Hostname
+		}
+		if address == "" {
+			continue
+		}
+		if hostname != "" {
+			return hostname, true
+		}
+		return address, true
+	}
+	return "", false
+}
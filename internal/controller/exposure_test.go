@@ -0,0 +1,121 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"maps"
+	"testing"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func newLoadBalancerAutoMQ() *infrav1.AutoMQ {
+	obj := &infrav1.AutoMQ{ObjectMeta: metav1.ObjectMeta{Name: "automq", Namespace: "default"}}
+	obj.Spec.Exposure = infrav1.ExposureSpec{
+		Type: infrav1.ExposureTypeLoadBalancer,
+		LoadBalancer: &infrav1.LoadBalancerSpec{
+			Annotations:       map[string]string{"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing"},
+			LoadBalancerClass: ptr.To("service.k8s.aws/nlb"),
+			HostnameTemplate:  "b{index}.{name}.kafka.example.com",
+			BootstrapHostname: "{name}.kafka.example.com",
+		},
+	}
+	return obj
+}
+
+func TestApplyExposure(t *testing.T) {
+	obj := newLoadBalancerAutoMQ()
+	svc := &v1.Service{}
+	applyExposure(obj, svc, brokerHostname(obj, 1))
+	if svc.Spec.Type != v1.ServiceTypeLoadBalancer || ptr.Deref(svc.Spec.LoadBalancerClass, "") != "service.k8s.aws/nlb" {
+		t.Errorf("the LoadBalancer service = %+v", svc.Spec)
+	}
+	if svc.Annotations[externalDNSHostnameAnnotation] != "b1.automq.kafka.example.com" ||
+		svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-scheme"] != "internet-facing" {
+		t.Errorf("the annotations of the LoadBalancer service = %v", svc.Annotations)
+	}
+
+	// the immutable class is kept once the service is a LoadBalancer
+	obj.Spec.Exposure.LoadBalancer.LoadBalancerClass = nil
+	applyExposure(obj, svc, brokerHostname(obj, 1))
+	if svc.Spec.LoadBalancerClass == nil {
+		t.Error("the class of the LoadBalancer service is changed")
+	}
+
+	obj.Spec.Exposure = infrav1.ExposureSpec{Type: infrav1.ExposureTypeNodePort}
+	applyExposure(obj, svc, brokerHostname(obj, 1))
+	if svc.Spec.Type != v1.ServiceTypeNodePort || svc.Spec.LoadBalancerClass != nil {
+		t.Errorf("the NodePort service = %+v", svc.Spec)
+	}
+	if _, ok := svc.Annotations[externalDNSHostnameAnnotation]; ok {
+		t.Errorf("the external-dns hostname is kept on the NodePort service")
+	}
+}
+
+func TestApplyExposureAnnotations(t *testing.T) {
+	obj := newLoadBalancerAutoMQ()
+	svc := &v1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"owner": "user"}}}
+	applyExposure(obj, svc, brokerHostname(obj, 1))
+
+	// the annotation dropped from the spec is removed in LoadBalancer mode
+	obj.Spec.Exposure.LoadBalancer.Annotations = map[string]string{"service.beta.kubernetes.io/aws-load-balancer-type": "external"}
+	applyExposure(obj, svc, brokerHostname(obj, 1))
+	if _, ok := svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-scheme"]; ok {
+		t.Errorf("the annotation dropped from the spec is kept: %v", svc.Annotations)
+	}
+	if svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-type"] != "external" {
+		t.Errorf("the annotation added to the spec is not applied: %v", svc.Annotations)
+	}
+
+	// all the applied annotations are removed when the exposure is changed, even when dropped from the spec
+	obj.Spec.Exposure = infrav1.ExposureSpec{Type: infrav1.ExposureTypeNodePort}
+	applyExposure(obj, svc, brokerHostname(obj, 1))
+	want := map[string]string{"owner": "user"}
+	if !maps.Equal(svc.Annotations, want) {
+		t.Errorf("the annotations of the NodePort service = %v, want %v", svc.Annotations, want)
+	}
+
+	// the service applied before the keys are recorded drops the annotations of the spec
+	obj = newLoadBalancerAutoMQ()
+	svc = &v1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		"owner": "user",
+		"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+		externalDNSHostnameAnnotation:                         "b1.automq.kafka.example.com",
+	}}}
+	obj.Spec.Exposure.Type = infrav1.ExposureTypeNodePort
+	applyExposure(obj, svc, brokerHostname(obj, 1))
+	if !maps.Equal(svc.Annotations, want) {
+		t.Errorf("the annotations of the legacy NodePort service = %v, want %v", svc.Annotations, want)
+	}
+}
+
+func TestLoadBalancerAddress(t *testing.T) {
+	svc := &v1.Service{}
+	if _, ok := loadBalancerAddress(svc, ""); ok {
+		t.Error("loadBalancerAddress() of the pending service is found")
+	}
+	svc.Status.LoadBalancer.Ingress = []v1.LoadBalancerIngress{{Hostname: "abc.elb.amazonaws.com"}}
+	if address, _ := loadBalancerAddress(svc, ""); address != "abc.elb.amazonaws.com" {
+		t.Errorf("loadBalancerAddress() = %s, want the ingress hostname", address)
+	}
+	if address, _ := loadBalancerAddress(svc, "b0.automq.kafka.example.com"); address != "b0.automq.kafka.example.com" {
+		t.Errorf("loadBalancerAddress() = %s, want the external-dns hostname", address)
+	}
+}
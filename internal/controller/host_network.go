@@ -0,0 +1,64 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// brokerDeploymentStrategy returns the strategy of the broker deployment. The broker on the host network holds the
+// host ports of its node, so the old pod is stopped before the new one is scheduled.
+func brokerDeploymentStrategy(obj *infrav1.AutoMQ) appsv1.DeploymentStrategy {
+	if obj.Spec.Broker.HostNetwork {
+		return appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType}
+	}
+	return appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType}
+}
+
+// applyHostNetwork runs the broker on the host network with the host ports of the container, and forces the hard
+// pod anti-affinity on the hostname so only one broker runs per node.
+func applyHostNetwork(spec *v1.PodSpec, obj *infrav1.AutoMQ) {
+	if !obj.Spec.Broker.HostNetwork {
+		spec.HostNetwork = false
+		spec.DNSPolicy = v1.DNSClusterFirst
+		return
+	}
+	spec.HostNetwork = true
+	spec.DNSPolicy = v1.DNSClusterFirstWithHostNet
+	for i := range spec.Containers {
+		for j := range spec.Containers[i].Ports {
+			port := &spec.Containers[i].Ports[j]
+			port.HostPort = port.ContainerPort
+		}
+	}
+	if spec.Affinity == nil {
+		spec.Affinity = &v1.Affinity{}
+	}
+	if spec.Affinity.PodAntiAffinity == nil {
+		spec.Affinity.PodAntiAffinity = &v1.PodAntiAffinity{}
+	}
+	spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(
+		spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, v1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: getAutoMQLabelMap(obj.GetName(), brokerRole),
+			},
+			TopologyKey: v1.LabelHostname,
+		})
+}
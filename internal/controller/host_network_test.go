@@ -0,0 +1,59 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApplyHostNetwork(t *testing.T) {
+	obj := &infrav1.AutoMQ{ObjectMeta: metav1.ObjectMeta{Name: "automq"}}
+	obj.Spec.RackAwareness = &infrav1.RackAwarenessSpec{}
+	obj.Spec.Broker.HostNetwork = true
+	spec := &v1.PodSpec{Containers: []v1.Container{{Name: brokerRole, Ports: []v1.ContainerPort{{Name: brokerRole, ContainerPort: 9092}}}}}
+	applyRackAwareness(spec, obj)
+	applyHostNetwork(spec, obj)
+	if !spec.HostNetwork || spec.DNSPolicy != v1.DNSClusterFirstWithHostNet {
+		t.Errorf("the pod spec = %+v, want the host network", spec)
+	}
+	if spec.Containers[0].Ports[0].HostPort != 9092 {
+		t.Errorf("the ports = %+v, want the host port 9092", spec.Containers[0].Ports)
+	}
+	antiAffinity := spec.Affinity.PodAntiAffinity
+	if len(antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution) != 1 ||
+		antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution[0].TopologyKey != v1.LabelHostname {
+		t.Errorf("the required pod anti-affinity = %v, want one broker per node", antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+	}
+	if len(antiAffinity.PreferredDuringSchedulingIgnoredDuringExecution) != 1 {
+		t.Error("the preferred pod anti-affinity of the rack awareness is not kept")
+	}
+	if brokerDeploymentStrategy(obj).Type != appsv1.RecreateDeploymentStrategyType {
+		t.Errorf("the strategy of the host network broker = %v, want Recreate", brokerDeploymentStrategy(obj).Type)
+	}
+
+	obj.Spec.Broker.HostNetwork = false
+	spec = &v1.PodSpec{HostNetwork: true, DNSPolicy: v1.DNSClusterFirstWithHostNet}
+	applyHostNetwork(spec, obj)
+	if spec.HostNetwork || spec.DNSPolicy != v1.DNSClusterFirst || spec.Affinity != nil {
+		t.Errorf("the pod spec = %+v, want the pod network", spec)
+	}
+}
@@ -17,17 +17,43 @@ limitations under the License.
 package controller
 
 import (
+	"fmt"
 	"strings"
 
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
 	"github.com/cuisongliu/automq-operator/defaults"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
 
-func sysctlContainer() v1.Container {
+// initContainers returns the init containers managed by the operator.
+func initContainers(obj *infrav1.AutoMQ) []v1.Container {
+	var containers []v1.Container
+	if obj.Spec.Sysctl.IsEnabled() {
+		containers = append(containers, sysctlContainer(obj.Spec.Sysctl))
+	}
+	return containers
+}
+
+// applyPodSysctls sets the namespaced sysctls into the pod level security context.
+func applyPodSysctls(spec *v1.PodSpec, sysctl infrav1.SysctlSpec) {
+	if len(sysctl.PodSysctls) == 0 {
+		return
+	}
+	if spec.SecurityContext == nil {
+		spec.SecurityContext = &v1.PodSecurityContext{}
+	}
+	spec.SecurityContext.Sysctls = append(spec.SecurityContext.Sysctls, sysctl.PodSysctls...)
+}
+
+func sysctlContainer(sysctl infrav1.SysctlSpec) v1.Container {
+	image := sysctl.Image
+	if image == "" {
+		image = defaults.BusyboxImageName
+	}
 	container := v1.Container{
 		Name:            "sysctl",
-		Image:           defaults.BusyboxImageName,
+		Image:           image,
 		Command:         nil,
 		ImagePullPolicy: "IfNotPresent",
 		SecurityContext: &v1.SecurityContext{
@@ -42,15 +68,24 @@ func sysctlContainer() v1.Container {
 		}},
 	}
 
+	cmds := sysctls
+	if len(sysctl.Sysctls) > 0 {
+		cmds = nil
+		for _, kv := range sysctl.Sysctls {
+			key, value, _ := strings.Cut(kv, "=")
+			cmds = append(cmds, fmt.Sprintf("sysctl -w %s=%q", strings.TrimSpace(key), strings.TrimSpace(value)))
+		}
+		cmds = append(cmds, limits...)
+	}
 	container.Command = []string{
 		"sh",
 		"-c",
-		strings.Join(sysctls, "\n"),
+		strings.Join(cmds, "\n"),
 	}
 	return container
 }
 
-var sysctls = []string{
+var sysctls = append([]string{
 	"sysctl -w fs.inotify.max_user_watches=8000000",
 	"sysctl -w fs.file-max=40265318",
 	"sysctl -w fs.inotify.max_user_instances=12800",
@@ -62,6 +97,9 @@ var sysctls = []string{
 	"sysctl -w net.ipv4.tcp_keepalive_intvl=75",
 	"sysctl -w net.ipv4.tcp_keepalive_probes=9",
 	"sysctl -w net.ipv4.tcp_keepalive_time=7200",
+}, limits...)
+
+var limits = []string{
 	"ulimit -a",
 	"mkdir -p /etc/security",
 	"echo \"* - nofile 1048576\" >> /etc/security/limits.conf",
@@ -0,0 +1,142 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"maps"
+	"slices"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// applyPodTemplate merges the user pod template overrides into the generated pod template.
+// The first container of the pod is the AutoMQ container. Everything generated by the operator
+// wins over the overrides, so the overrides can only add to the pod. It runs against the live
+// pod template on every reconcile, so the fields only set by the overrides are assigned rather
+// than merged, and the removed overrides are removed from the pod.
+func applyPodTemplate(template *v1.PodTemplateSpec, overrides *infrav1.PodTemplate) {
+	spec := &template.Spec
+	spec.NodeSelector = nil
+	spec.Tolerations = nil
+	spec.TopologySpreadConstraints = nil
+	spec.PriorityClassName = ""
+	spec.ServiceAccountName = ""
+	// the deprecated field is defaulted into the service account name, it is cleared with it
+	spec.DeprecatedServiceAccount = ""
+	if overrides == nil {
+		return
+	}
+	template.Labels = mergeStringMap(template.Labels, overrides.Labels)
+	template.Annotations = mergeStringMap(template.Annotations, overrides.Annotations)
+
+	spec.NodeSelector = maps.Clone(overrides.NodeSelector)
+	spec.Tolerations = slices.Clone(overrides.Tolerations)
+	spec.TopologySpreadConstraints = slices.Clone(overrides.TopologySpreadConstraints)
+	spec.PriorityClassName = overrides.PriorityClassName
+	spec.ServiceAccountName = overrides.ServiceAccountName
+	spec.DeprecatedServiceAccount = overrides.ServiceAccountName
+	spec.SecurityContext = mergePodSecurityContext(spec.SecurityContext, overrides.SecurityContext)
+
+	volumes := make(map[string]bool)
+	for _, v := range spec.Volumes {
+		volumes[v.Name] = true
+	}
+	for _, v := range overrides.Volumes {
+		if !volumes[v.Name] {
+			spec.Volumes = append(spec.Volumes, v)
+		}
+	}
+	if len(spec.Containers) > 0 {
+		mounts := make(map[string]bool)
+		for _, m := range spec.Containers[0].VolumeMounts {
+			mounts[m.MountPath] = true
+		}
+		for _, m := range overrides.VolumeMounts {
+			if !mounts[m.MountPath] {
+				spec.Containers[0].VolumeMounts = append(spec.Containers[0].VolumeMounts, m)
+			}
+		}
+	}
+	spec.Containers = appendContainers(spec.Containers, overrides.Sidecars)
+	spec.InitContainers = appendContainers(spec.InitContainers, overrides.InitContainers)
+}
+
+// mergePodSecurityContext merges the user pod security context with the one generated by the operator,
+// the fields set by the operator take precedence.
+func mergePodSecurityContext(managed, extra *v1.PodSecurityContext) *v1.PodSecurityContext {
+	if extra == nil {
+		return managed
+	}
+	merged := extra.DeepCopy()
+	if managed == nil {
+		return merged
+	}
+	if managed.RunAsUser != nil {
+		merged.RunAsUser = managed.RunAsUser
+	}
+	if managed.RunAsGroup != nil {
+		merged.RunAsGroup = managed.RunAsGroup
+	}
+	if managed.RunAsNonRoot != nil {
+		merged.RunAsNonRoot = managed.RunAsNonRoot
+	}
+	if managed.FSGroup != nil {
+		merged.FSGroup = managed.FSGroup
+	}
+	if managed.SeccompProfile != nil {
+		merged.SeccompProfile = managed.SeccompProfile
+	}
+	sysctls := make(map[string]bool)
+	for _, s := range managed.Sysctls {
+		sysctls[s.Name] = true
+	}
+	for _, s := range merged.Sysctls {
+		if !sysctls[s.Name] {
+			managed.Sysctls = append(managed.Sysctls, s)
+		}
+	}
+	merged.Sysctls = managed.Sysctls
+	return merged
+}
+
+func mergeStringMap(managed, extra map[string]string) map[string]string {
+	if len(extra) == 0 {
+		return managed
+	}
+	merged := make(map[string]string, len(managed)+len(extra))
+	for k, v := range extra {
+		merged[k] = v
+	}
+	for k, v := range managed {
+		merged[k] = v
+	}
+	return merged
+}
+
+func appendContainers(managed, extra []v1.Container) []v1.Container {
+	names := make(map[string]bool)
+	for _, c := range managed {
+		names[c.Name] = true
+	}
+	for _, c := range extra {
+		if !names[c.Name] {
+			managed = append(managed, c)
+		}
+	}
+	return managed
+}
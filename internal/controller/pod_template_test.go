@@ -0,0 +1,90 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+// mutatePodTemplate generates the pod template like the mutate function of the deployment, the fields not
+// generated by the operator are kept from the live template.
+func mutatePodTemplate(template *v1.PodTemplateSpec, overrides *infrav1.PodTemplate) {
+	template.Labels = map[string]string{"app.kubernetes.io/name": "automq"}
+	template.Annotations = map[string]string{"configmap/script-hash": "hash"}
+	template.Spec.Volumes = []v1.Volume{{Name: "script"}}
+	template.Spec.Containers = []v1.Container{{Name: brokerRole, VolumeMounts: []v1.VolumeMount{{Name: "script", MountPath: "/opt/kafka/scripts"}}}}
+	template.Spec.SecurityContext = &v1.PodSecurityContext{RunAsNonRoot: ptr.To(true)}
+	applyPodTemplate(template, overrides)
+}
+
+func TestApplyPodTemplate(t *testing.T) {
+	overrides := &infrav1.PodTemplate{
+		Labels:                    map[string]string{"team": "kafka", "app.kubernetes.io/name": "override"},
+		NodeSelector:              map[string]string{"disk": "ssd", "pool": "kafka"},
+		Tolerations:               []v1.Toleration{{Key: "dedicated", Value: "kafka", Effect: v1.TaintEffectNoSchedule}},
+		TopologySpreadConstraints: []v1.TopologySpreadConstraint{{MaxSkew: 1, TopologyKey: v1.LabelTopologyZone}},
+		PriorityClassName:         "high",
+		ServiceAccountName:        "automq",
+		SecurityContext:           &v1.PodSecurityContext{RunAsNonRoot: ptr.To(false), FSGroup: ptr.To[int64](1000)},
+		Volumes:                   []v1.Volume{{Name: "script"}, {Name: "extra"}},
+		VolumeMounts:              []v1.VolumeMount{{Name: "extra", MountPath: "/extra"}},
+		Sidecars:                  []v1.Container{{Name: brokerRole}, {Name: "exporter"}},
+	}
+	template := &v1.PodTemplateSpec{}
+	mutatePodTemplate(template, overrides)
+	if template.Labels["app.kubernetes.io/name"] != "automq" || template.Labels["team"] != "kafka" {
+		t.Errorf("the labels = %v, want the operator labels with the extra ones", template.Labels)
+	}
+	if len(template.Spec.Volumes) != 2 || len(template.Spec.Containers) != 2 || len(template.Spec.Containers[0].VolumeMounts) != 2 {
+		t.Errorf("the pod spec = %+v, want the extra volume, mount and sidecar", template.Spec)
+	}
+	if !*template.Spec.SecurityContext.RunAsNonRoot || template.Spec.SecurityContext.FSGroup == nil {
+		t.Errorf("the security context = %+v, want the operator fields to win", template.Spec.SecurityContext)
+	}
+
+	// the mutate function is stable on the live template
+	first := template.DeepCopy()
+	mutatePodTemplate(template, overrides)
+	if !reflect.DeepEqual(first, template) {
+		t.Errorf("the pod template is changed by the second reconcile:\nfirst:  %+v\nsecond: %+v", first.Spec, template.Spec)
+	}
+
+	// the removed and changed overrides are removed from the pod
+	overrides.NodeSelector = map[string]string{"disk": "ssd"}
+	overrides.Tolerations = nil
+	overrides.PriorityClassName = "low"
+	overrides.ServiceAccountName = ""
+	mutatePodTemplate(template, overrides)
+	spec := template.Spec
+	if !reflect.DeepEqual(spec.NodeSelector, map[string]string{"disk": "ssd"}) || len(spec.Tolerations) != 0 {
+		t.Errorf("the node selector = %v and the tolerations = %v, want the removed ones removed", spec.NodeSelector, spec.Tolerations)
+	}
+	if spec.PriorityClassName != "low" || spec.ServiceAccountName != "" || spec.DeprecatedServiceAccount != "" {
+		t.Errorf("the priority class = %s and the service account = %s, want them changed", spec.PriorityClassName, spec.ServiceAccountName)
+	}
+
+	mutatePodTemplate(template, nil)
+	spec = template.Spec
+	if spec.NodeSelector != nil || spec.TopologySpreadConstraints != nil || spec.PriorityClassName != "" {
+		t.Errorf("the pod spec = %+v, want the overrides removed", spec)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// rackAwareReplicaSelector lets the consumers with the client.rack fetch from the brokers in the same rack.
+const rackAwareReplicaSelector = "org.apache.kafka.common.replica.RackAwareReplicaSelector"
+
+func rackTopologyKey(obj *infrav1.AutoMQ) string {
+	if obj.Spec.RackAwareness == nil {
+		return ""
+	}
+	if obj.Spec.RackAwareness.TopologyKey == "" {
+		return v1.LabelTopologyZone
+	}
+	return obj.Spec.RackAwareness.TopologyKey
+}
+
+// rackAwarenessEnvs returns the environment variables of the broker, up.sh resolves the label of the node
+// from the operator node API and sets it as broker.rack.
+func rackAwarenessEnvs(obj *infrav1.AutoMQ) []v1.EnvVar {
+	topologyKey := rackTopologyKey(obj)
+	if topologyKey == "" {
+		return nil
+	}
+	return []v1.EnvVar{
+		{
+			Name:  "RACK_TOPOLOGY_KEY",
+			Value: topologyKey,
+		},
+		{
+			Name:  "KAFKA_CFG_REPLICA_SELECTOR_CLASS",
+			Value: rackAwareReplicaSelector,
+		},
+	}
+}
+
+// applyRackAwareness spreads the brokers across the racks by the preferred pod anti-affinity,
+// unless the pod anti-affinity of the broker is set.
+func applyRackAwareness(spec *v1.PodSpec, obj *infrav1.AutoMQ) {
+	topologyKey := rackTopologyKey(obj)
+	if topologyKey == "" {
+		return
+	}
+	if obj.Spec.Broker.Affinity != nil && obj.Spec.Broker.Affinity.PodAntiAffinity != nil {
+		return
+	}
+	if spec.Affinity == nil {
+		spec.Affinity = &v1.Affinity{}
+	}
+	spec.Affinity.PodAntiAffinity = &v1.PodAntiAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []v1.WeightedPodAffinityTerm{
+			{
+				Weight: 100,
+				PodAffinityTerm: v1.PodAffinityTerm{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: getAutoMQLabelMap(obj.GetName(), brokerRole),
+					},
+					TopologyKey: topologyKey,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestApplyRackAwareness(t *testing.T) {
+	obj := &infrav1.AutoMQ{}
+	obj.Name = "automq"
+
+	spec := &v1.PodSpec{}
+	applyRackAwareness(spec, obj)
+	if spec.Affinity != nil {
+		t.Fatal("the affinity is not expected without the rack awareness")
+	}
+	if envs := rackAwarenessEnvs(obj); len(envs) != 0 {
+		t.Fatalf("the envs are not expected without the rack awareness: %v", envs)
+	}
+
+	obj.Spec.RackAwareness = &infrav1.RackAwarenessSpec{}
+	applyRackAwareness(spec, obj)
+	terms := spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+	if len(terms) != 1 || terms[0].PodAffinityTerm.TopologyKey != v1.LabelTopologyZone {
+		t.Fatalf("unexpected pod anti-affinity: %v", terms)
+	}
+	if got, _ := envValue(rackAwarenessEnvs(obj), "RACK_TOPOLOGY_KEY"); got != v1.LabelTopologyZone {
+		t.Errorf("RACK_TOPOLOGY_KEY = %q, want %q", got, v1.LabelTopologyZone)
+	}
+
+	obj.Spec.Broker.Affinity = &infrav1.AffinitySpec{PodAntiAffinity: &infrav1.PodAntiAffinity{Type: "hard"}}
+	spec = &v1.PodSpec{}
+	applyRackAwareness(spec, obj)
+	if spec.Affinity != nil {
+		t.Error("the pod anti-affinity of the broker is not expected to be overridden")
+	}
+}
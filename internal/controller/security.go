@@ -0,0 +1,116 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	defaultRunAsID = int64(1000)
+
+	kafkaConfigPath = "/opt/kafka/kafka/config"
+	kafkaLogsPath   = "/opt/kafka/kafka/logs"
+	runInfoFile     = "/tmp/run.info"
+)
+
+// restrictedContainerSecurityContext is the container security context required by the restricted pod security standard.
+func restrictedContainerSecurityContext() *v1.SecurityContext {
+	return &v1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.To(false),
+		ReadOnlyRootFilesystem:   ptr.To(true),
+		RunAsNonRoot:             ptr.To(true),
+		Capabilities: &v1.Capabilities{
+			Drop: []v1.Capability{"ALL"},
+		},
+	}
+}
+
+// configContainer copies the kafka configuration of the image into the writable config volume,
+// so that the start script is able to render it with a read-only root filesystem.
+func configContainer(image string) v1.Container {
+	return v1.Container{
+		Name:            "config",
+		Image:           image,
+		ImagePullPolicy: v1.PullIfNotPresent,
+		Command: []string{
+			"sh",
+			"-c",
+			"cp -a " + kafkaConfigPath + "/. /kafka-config/",
+		},
+		VolumeMounts: []v1.VolumeMount{
+			{
+				Name:      "kafka-config",
+				MountPath: "/kafka-config",
+			},
+		},
+		SecurityContext: restrictedContainerSecurityContext(),
+	}
+}
+
+// applyRestrictedSecurity makes the generated pod compliant with the restricted pod security standard.
+// The first container of the pod is the AutoMQ container.
+func applyRestrictedSecurity(spec *v1.PodSpec, obj *infrav1.AutoMQ) {
+	security := obj.Spec.Security
+	if !security.Restricted {
+		return
+	}
+	if spec.SecurityContext == nil {
+		spec.SecurityContext = &v1.PodSecurityContext{}
+	}
+	spec.SecurityContext.RunAsNonRoot = ptr.To(true)
+	spec.SecurityContext.RunAsUser = ptr.To(ptr.Deref(security.RunAsUser, defaultRunAsID))
+	spec.SecurityContext.RunAsGroup = ptr.To(ptr.Deref(security.RunAsGroup, defaultRunAsID))
+	spec.SecurityContext.FSGroup = ptr.To(ptr.Deref(security.FSGroup, defaultRunAsID))
+	spec.SecurityContext.SeccompProfile = &v1.SeccompProfile{
+		Type: v1.SeccompProfileTypeRuntimeDefault,
+	}
+
+	for _, name := range []string{"kafka-config", "kafka-logs", "tmp"} {
+		spec.Volumes = append(spec.Volumes, v1.Volume{
+			Name: name,
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		})
+	}
+	if len(spec.Containers) > 0 {
+		container := &spec.Containers[0]
+		spec.InitContainers = append(spec.InitContainers, configContainer(container.Image))
+		container.SecurityContext = restrictedContainerSecurityContext()
+		container.VolumeMounts = append(container.VolumeMounts,
+			v1.VolumeMount{
+				Name:      "kafka-config",
+				MountPath: kafkaConfigPath,
+			},
+			v1.VolumeMount{
+				Name:      "kafka-logs",
+				MountPath: kafkaLogsPath,
+			},
+			v1.VolumeMount{
+				Name:      "tmp",
+				MountPath: "/tmp",
+			},
+		)
+		container.Env = append(container.Env, v1.EnvVar{
+			Name:  "AUTOMQ_RUN_INFO_FILE",
+			Value: runInfoFile,
+		})
+	}
+}
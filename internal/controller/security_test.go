@@ -0,0 +1,114 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// checkRestrictedPodSpec checks the pod spec passes the restricted pod security standard with the writable
+// directories of the AutoMQ container on the emptyDir volumes.
+func checkRestrictedPodSpec(t *testing.T, name string, spec v1.PodSpec) {
+	t.Helper()
+	podSecurity := spec.SecurityContext
+	if podSecurity == nil || !ptr.Deref(podSecurity.RunAsNonRoot, false) ||
+		ptr.Deref(podSecurity.RunAsUser, 0) != defaultRunAsID || ptr.Deref(podSecurity.FSGroup, 0) != defaultRunAsID ||
+		podSecurity.SeccompProfile == nil || podSecurity.SeccompProfile.Type != v1.SeccompProfileTypeRuntimeDefault {
+		t.Errorf("%s: the pod security context = %+v", name, podSecurity)
+	}
+	if spec.HostNetwork {
+		t.Errorf("%s: the host network is not allowed", name)
+	}
+	containers := append(append([]v1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, container := range containers {
+		security := container.SecurityContext
+		if security == nil || ptr.Deref(security.AllowPrivilegeEscalation, true) || !ptr.Deref(security.RunAsNonRoot, false) ||
+			!ptr.Deref(security.ReadOnlyRootFilesystem, false) || ptr.Deref(security.Privileged, false) {
+			t.Errorf("%s: the security context of the container %s = %+v", name, container.Name, security)
+			continue
+		}
+		if security.Capabilities == nil || len(security.Capabilities.Drop) != 1 || security.Capabilities.Drop[0] != "ALL" ||
+			len(security.Capabilities.Add) != 0 {
+			t.Errorf("%s: the capabilities of the container %s = %+v, want ALL dropped", name, container.Name, security.Capabilities)
+		}
+	}
+	emptyDirs := map[string]bool{}
+	for _, volume := range spec.Volumes {
+		if volume.HostPath != nil {
+			t.Errorf("%s: the hostPath volume %s is not allowed", name, volume.Name)
+		}
+		if volume.EmptyDir != nil {
+			emptyDirs[volume.Name] = true
+		}
+	}
+	mounts := map[string]string{}
+	for _, mount := range spec.Containers[0].VolumeMounts {
+		mounts[mount.MountPath] = mount.Name
+	}
+	for _, path := range []string{kafkaConfigPath, kafkaLogsPath, "/tmp"} {
+		if !emptyDirs[mounts[path]] {
+			t.Errorf("%s: the writable path %s is mounted from %q, want an emptyDir volume", name, path, mounts[path])
+		}
+	}
+}
+
+func TestRestrictedPodSpec(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+	obj := &infrav1.AutoMQ{ObjectMeta: metav1.ObjectMeta{Name: "automq", Namespace: "default"}}
+	obj.Spec.Controller.Replicas = 1
+	obj.Spec.Broker.Replicas = 1
+	obj.Spec.Sysctl.Enable = ptr.To(false)
+	obj.Spec.Security.Restricted = true
+	index := int32(0)
+	r := &AutoMQReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(obj, &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: getAutoMQName(brokerRole, &index), Namespace: "default"},
+			Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Name: brokerRole, Port: 9092, NodePort: 30001}}},
+		}).Build(),
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+		MountTZ:  true,
+	}
+	if err := r.syncControllerDeploy(ctx, obj, index); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.syncBrokerDeploy(ctx, obj, index); err != nil {
+		t.Fatal(err)
+	}
+	for _, role := range []string{controllerRole, brokerRole} {
+		deploy := &appsv1.Deployment{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: "default", Name: getAutoMQName(role, &index)}, deploy); err != nil {
+			t.Fatal(err)
+		}
+		checkRestrictedPodSpec(t, deploy.Name, deploy.Spec.Template.Spec)
+	}
+}
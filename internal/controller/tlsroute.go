@@ -0,0 +1,241 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tlsroutes,verbs=get;list;watch;create;update;delete
+
+const (
+	// externalListenerName is the name of the TLS listener of the brokers routed by the TLSRoutes
+	externalListenerName = "external"
+	externalListenerPort = 9094
+	// externalTLSDir is the directory of the certificate secret of the TLS listener in the broker container
+	externalTLSDir = "/opt/kafka/external-tls"
+)
+
+// tlsRouteGVK is the TLSRoute of the Gateway API, it is read as unstructured so the Gateway API CRDs are optional.
+var tlsRouteGVK = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Kind: "TLSRoute"}
+
+func tlsRoutePort(obj *infrav1.AutoMQ) int32 {
+	if tr := obj.Spec.Exposure.TLSRoute; tr != nil && tr.Port != 0 {
+		return tr.Port
+	}
+	return 443
+}
+
+func externalServicePort() v1.ServicePort {
+	return v1.ServicePort{
+		Name:       externalListenerName,
+		Port:       externalListenerPort,
+		TargetPort: intstr.FromString(externalListenerName),
+		Protocol:   v1.ProtocolTCP,
+	}
+}
+
+// externalListenerEnvs returns the environment variables of the TLS listener of the broker in TLSRoute mode.
+func externalListenerEnvs(obj *infrav1.AutoMQ) []v1.EnvVar {
+	if !obj.IsTLSRoute() {
+		return nil
+	}
+	return []v1.EnvVar{
+		{
+			Name:  "KAFKA_EXTERNAL_LISTENER_PORT",
+			Value: strconv.Itoa(externalListenerPort),
+		},
+		{
+			Name:  "KAFKA_EXTERNAL_TLS_DIR",
+			Value: externalTLSDir,
+		},
+	}
+}
+
+// applyExternalListener mounts the certificate secret and exposes the port of the TLS listener in TLSRoute mode.
+func applyExternalListener(spec *v1.PodSpec, obj *infrav1.AutoMQ) {
+	tr := obj.Spec.Exposure.TLSRoute
+	if !obj.IsTLSRoute() || tr == nil || len(spec.Containers) == 0 {
+		return
+	}
+	spec.Volumes = append(spec.Volumes, v1.Volume{
+		Name: "external-tls",
+		VolumeSource: v1.VolumeSource{
+			Secret: &v1.SecretVolumeSource{
+				SecretName: tr.CertificateSecretName,
+			},
+		},
+	})
+	container := &spec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, v1.VolumeMount{
+		Name:      "external-tls",
+		MountPath: externalTLSDir,
+		ReadOnly:  true,
+	})
+	container.Ports = append(container.Ports, v1.ContainerPort{
+		Name:          externalListenerName,
+		ContainerPort: externalListenerPort,
+		Protocol:      v1.ProtocolTCP,
+	})
+}
+
+// newTLSRoute returns the TLSRoute routing the SNI hostname to the TLS listener of the service.
+func newTLSRoute(obj *infrav1.AutoMQ, name, hostname string) *unstructured.Unstructured {
+	tr := obj.Spec.Exposure.TLSRoute
+	parentRef := map[string]interface{}{"name": tr.GatewayName}
+	if tr.GatewayNamespace != "" {
+		parentRef["namespace"] = tr.GatewayNamespace
+	}
+	if tr.SectionName != "" {
+		parentRef["sectionName"] = tr.SectionName
+	}
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(tlsRouteGVK)
+	route.SetNamespace(obj.Namespace)
+	route.SetName(name)
+	route.Object["spec"] = map[string]interface{}{
+		"parentRefs": []interface{}{parentRef},
+		"hostnames":  []interface{}{hostname},
+		"rules": []interface{}{
+			map[string]interface{}{
+				"backendRefs": []interface{}{
+					map[string]interface{}{"name": name, "port": int64(externalListenerPort)},
+				},
+			},
+		},
+	}
+	return route
+}
+
+// syncTLSRoutes creates the TLSRoutes of the brokers and the bootstrap in TLSRoute mode, and deletes the stale
+// routes of the removed brokers or of the previous exposure. The routes are skipped with the condition when the
+// Gateway API CRDs are not installed, without blocking the rest of the reconciliation.
+func (r *AutoMQReconciler) syncTLSRoutes(ctx context.Context, obj *infrav1.AutoMQ) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+	conditionType := "SyncTLSRouteReady"
+	if _, err := r.Client.RESTMapper().RESTMapping(tlsRouteGVK.GroupKind(), tlsRouteGVK.Version); err != nil {
+		if !meta.IsNoMatchError(err) {
+			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+				Type:               conditionType,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: obj.Generation,
+				Reason:             "TLSRouteReconciling",
+				Message:            fmt.Sprintf("Failed to discover the TLSRoute of the Gateway API: (%s)", err),
+			})
+			return ctrl.Result{}, err
+		}
+		if !obj.IsTLSRoute() {
+			meta.RemoveStatusCondition(&obj.Status.Conditions, conditionType)
+			return ctrl.Result{}, nil
+		}
+		log.Info("The Gateway API CRDs are not installed, the TLSRoutes are skipped", "name", obj.Name)
+		if meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: obj.Generation,
+			Reason:             "GatewayAPINotInstalled",
+			Message:            fmt.Sprintf("The %s CRD of the Gateway API is not installed, the TLSRoutes are skipped", tlsRouteGVK.GroupKind()),
+		}) {
+			r.Recorder.Eventf(obj, v1.EventTypeWarning, "GatewayAPINotInstalled", "The %s CRD of the Gateway API is not installed, the TLSRoutes are skipped", tlsRouteGVK.GroupKind())
+		}
+		// the step is completed so the status keeps up with the spec, the CRDs are checked again on the status requeue
+		return ctrl.Result{}, nil
+	}
+
+	labelMap := getAutoMQLabelMap(obj.GetName(), brokerRole)
+	desired := map[string]*unstructured.Unstructured{}
+	if obj.IsTLSRoute() {
+		for i := int32(0); i < obj.Spec.Broker.Replicas; i++ {
+			name := getAutoMQName(brokerRole, &i)
+			desired[name] = newTLSRoute(obj, name, brokerHostname(obj, i))
+		}
+		name := getAutoMQName(brokerRole+"-bootstrap", nil)
+		desired[name] = newTLSRoute(obj, name, bootstrapHostname(obj))
+	}
+	for name, want := range desired {
+		if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			route := &unstructured.Unstructured{}
+			route.SetGroupVersionKind(tlsRouteGVK)
+			route.SetNamespace(obj.Namespace)
+			route.SetName(name)
+			change, err := controllerutil.CreateOrUpdate(ctx, r.Client, route, func() error {
+				route.SetLabels(labelMap)
+				route.Object["spec"] = want.Object["spec"]
+				return controllerutil.SetControllerReference(obj, route, r.Scheme)
+			})
+			if err != nil {
+				return err
+			}
+			log.V(1).Info("create or update tlsroute by AutoMQ", "name", name, "OperationResult", change)
+			return nil
+		}); err != nil {
+			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+				Type:               conditionType,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: obj.Generation,
+				Reason:             "TLSRouteReconciling",
+				Message:            fmt.Sprintf("Failed to create tlsroute %s for the custom resource (%s): (%s)", name, obj.Name, err),
+			})
+			log.Error(err, "Failed to create tlsroute for the custom resource", "name", obj.Name, "route", name)
+			return ctrl.Result{}, err
+		}
+	}
+
+	routes := &unstructured.UnstructuredList{}
+	routes.SetGroupVersionKind(tlsRouteGVK.GroupVersion().WithKind(tlsRouteGVK.Kind + "List"))
+	if err := r.List(ctx, routes, client.InNamespace(obj.Namespace), client.MatchingLabels(labelMap)); err != nil {
+		return ctrl.Result{}, err
+	}
+	for i := range routes.Items {
+		route := &routes.Items[i]
+		if _, ok := desired[route.GetName()]; ok {
+			continue
+		}
+		if err := r.Delete(ctx, route); client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to delete the stale tlsroute", "name", obj.Name, "route", route.GetName())
+			return ctrl.Result{}, err
+		}
+		log.Info("delete the stale tlsroute", "name", obj.Name, "route", route.GetName())
+	}
+	if !obj.IsTLSRoute() {
+		meta.RemoveStatusCondition(&obj.Status.Conditions, conditionType)
+		return ctrl.Result{}, nil
+	}
+	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: obj.Generation,
+		Reason:             "TLSRouteReconciling",
+		Message:            fmt.Sprintf("TLSRoutes for the custom resource (%s) have been created", obj.Name),
+	})
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	infrav1 "github.com/cuisongliu/automq-operator/api/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTLSRouteAutoMQ() *infrav1.AutoMQ {
+	obj := &infrav1.AutoMQ{ObjectMeta: metav1.ObjectMeta{Name: "automq", Namespace: "default", UID: "automq-uid"}}
+	obj.Spec.Broker.Replicas = 2
+	obj.Spec.Exposure = infrav1.ExposureSpec{
+		Type: infrav1.ExposureTypeTLSRoute,
+		TLSRoute: &infrav1.TLSRouteSpec{
+			GatewayName:           "kafka",
+			GatewayNamespace:      "gateway-system",
+			Port:                  9443,
+			HostnameTemplate:      "b{index}.{name}.kafka.example.com",
+			BootstrapHostname:     "{name}.kafka.example.com",
+			CertificateSecretName: "automq-tls",
+		},
+	}
+	return obj
+}
+
+func newTLSRouteReconciler(installed bool) *AutoMQReconciler {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{tlsRouteGVK.GroupVersion()})
+	if installed {
+		mapper.Add(tlsRouteGVK, meta.RESTScopeNamespace)
+	}
+	return &AutoMQReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper).Build(),
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+	}
+}
+
+func listTLSRoutes(t *testing.T, r *AutoMQReconciler) map[string]unstructured.Unstructured {
+	routes := &unstructured.UnstructuredList{}
+	routes.SetGroupVersionKind(tlsRouteGVK.GroupVersion().WithKind(tlsRouteGVK.Kind + "List"))
+	if err := r.List(context.Background(), routes, client.InNamespace("default")); err != nil {
+		t.Fatal(err)
+	}
+	names := map[string]unstructured.Unstructured{}
+	for _, route := range routes.Items {
+		names[route.GetName()] = route
+	}
+	return names
+}
+
+func TestSyncTLSRoutes(t *testing.T) {
+	ctx := context.Background()
+	r := newTLSRouteReconciler(true)
+	obj := newTLSRouteAutoMQ()
+	if _, err := r.syncTLSRoutes(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	routes := listTLSRoutes(t, r)
+	if len(routes) != 3 {
+		t.Fatalf("the TLSRoutes = %v, want the 2 brokers and the bootstrap", routes)
+	}
+	route, ok := routes["automq-broker-1"]
+	if !ok {
+		t.Fatal("the TLSRoute of the broker 1 is not created")
+	}
+	hostnames, _, _ := unstructured.NestedStringSlice(route.Object, "spec", "hostnames")
+	if len(hostnames) != 1 || hostnames[0] != "b1.automq.kafka.example.com" {
+		t.Errorf("the hostnames of the TLSRoute = %v", hostnames)
+	}
+	parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	if len(parentRefs) != 1 || parentRefs[0].(map[string]interface{})["namespace"] != "gateway-system" {
+		t.Errorf("the parentRefs of the TLSRoute = %v", parentRefs)
+	}
+	if owner := metav1.GetControllerOf(&route); owner == nil || owner.UID != obj.UID {
+		t.Errorf("the owner of the TLSRoute = %v, want the AutoMQ", owner)
+	}
+	if !meta.IsStatusConditionTrue(obj.Status.Conditions, "SyncTLSRouteReady") {
+		t.Errorf("the conditions = %v, want SyncTLSRouteReady", obj.Status.Conditions)
+	}
+
+	// the route of the removed broker is deleted
+	obj.Spec.Broker.Replicas = 1
+	if _, err := r.syncTLSRoutes(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok = listTLSRoutes(t, r)["automq-broker-1"]; ok {
+		t.Error("the TLSRoute of the removed broker is kept")
+	}
+
+	// all the routes are deleted when the exposure is changed
+	obj.Spec.Exposure = infrav1.ExposureSpec{Type: infrav1.ExposureTypeNodePort}
+	if _, err := r.syncTLSRoutes(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+	if routes = listTLSRoutes(t, r); len(routes) != 0 {
+		t.Errorf("the TLSRoutes of the NodePort exposure = %v", routes)
+	}
+	if meta.FindStatusCondition(obj.Status.Conditions, "SyncTLSRouteReady") != nil {
+		t.Error("the SyncTLSRouteReady condition is kept in NodePort mode")
+	}
+}
+
+func TestSyncTLSRoutesGatewayAPINotInstalled(t *testing.T) {
+	ctx := context.Background()
+	r := newTLSRouteReconciler(false)
+	obj := newTLSRouteAutoMQ()
+	result, err := r.syncTLSRoutes(ctx, obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the missing CRDs do not block the completion of the reconciliation
+	if !result.IsZero() {
+		t.Errorf("syncTLSRoutes() = %+v, want completed", result)
+	}
+	condition := meta.FindStatusCondition(obj.Status.Conditions, "SyncTLSRouteReady")
+	if condition == nil || condition.Status != metav1.ConditionFalse || condition.Reason != "GatewayAPINotInstalled" {
+		t.Errorf("the SyncTLSRouteReady condition = %+v, want GatewayAPINotInstalled", condition)
+	}
+
+	obj.Spec.Exposure = infrav1.ExposureSpec{Type: infrav1.ExposureTypeNodePort}
+	if result, err = r.syncTLSRoutes(ctx, obj); err != nil || result.RequeueAfter != 0 {
+		t.Errorf("syncTLSRoutes() of the NodePort exposure = %+v, %v", result, err)
+	}
+}
+
+func TestApplyExternalListener(t *testing.T) {
+	obj := newTLSRouteAutoMQ()
+	spec := &v1.PodSpec{Containers: []v1.Container{{Name: "automq"}}}
+	applyExternalListener(spec, obj)
+	if len(spec.Volumes) != 1 || spec.Volumes[0].Secret.SecretName != "automq-tls" {
+		t.Errorf("the volumes = %+v, want the certificate secret", spec.Volumes)
+	}
+	if ports := spec.Containers[0].Ports; len(ports) != 1 || ports[0].ContainerPort != externalListenerPort {
+		t.Errorf("the ports = %+v, want the external listener", ports)
+	}
+	if address := bootstrapExternalAddress(obj, &v1.Service{}); address != "automq.kafka.example.com:9443" {
+		t.Errorf("bootstrapExternalAddress() = %s", address)
+	}
+
+	obj.Spec.Exposure = infrav1.ExposureSpec{Type: infrav1.ExposureTypeNodePort}
+	spec = &v1.PodSpec{Containers: []v1.Container{{Name: "automq"}}}
+	applyExternalListener(spec, obj)
+	if len(spec.Volumes) != 0 || len(externalListenerEnvs(obj)) != 0 {
+		t.Errorf("the external listener is applied in NodePort mode")
+	}
+}
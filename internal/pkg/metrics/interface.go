@@ -0,0 +1,53 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Querier interface {
+	// Query evaluates the instant query and returns the value of the single sample in the result.
+	Query(ctx context.Context, query string) (float64, error)
+}
+
+type Config struct {
+	Type string
+	// Address of the metrics service.
+	Address string
+	// Timeout of the query (default: 10 sec).
+	Timeout time.Duration
+}
+
+func NewQuerier(cfg Config) (Querier, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("the address of the metrics service is empty")
+	}
+	if !strings.HasPrefix(cfg.Address, "http") {
+		cfg.Address = "http://" + cfg.Address
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.Type == "" || cfg.Type == "prometheus" {
+		return newPrometheusQuerier(cfg)
+	}
+	return nil, fmt.Errorf("not support this metrics type: %s", cfg.Type)
+}
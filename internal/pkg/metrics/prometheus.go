@@ -0,0 +1,69 @@
+/*
+Copyright 2024 cuisongliu@qq.com.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+type prometheusQuerier struct {
+	api     promv1.API
+	timeout time.Duration
+}
+
+func newPrometheusQuerier(cfg Config) (Querier, error) {
+	client, err := api.NewClient(api.Config{Address: cfg.Address})
+	if err != nil {
+		return nil, err
+	}
+	return &prometheusQuerier{api: promv1.NewAPI(client), timeout: cfg.Timeout}, nil
+}
+
+func (p *prometheusQuerier) Query(ctx context.Context, query string) (float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	result, _, err := p.api.Query(ctx, query, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("query %s: %w", query, err)
+	}
+	var value model.SampleValue
+	switch v := result.(type) {
+	case model.Vector:
+		if len(v) == 0 {
+			return 0, fmt.Errorf("query %s: no data", query)
+		}
+		if len(v) > 1 {
+			return 0, fmt.Errorf("query %s: expected 1 sample, got %d", query, len(v))
+		}
+		value = v[0].Value
+	case *model.Scalar:
+		value = v.Value
+	default:
+		return 0, fmt.Errorf("query %s: unsupported result type %s", query, result.Type())
+	}
+	if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+		return 0, fmt.Errorf("query %s: invalid value %s", query, value)
+	}
+	return float64(value), nil
+}
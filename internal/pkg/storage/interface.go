@@ -36,9 +36,11 @@ type Storage interface {
 
 type Bucket interface {
 	MkBucket(ctx context.Context, bucketName string) error
+	BucketExists(ctx context.Context, bucketName string) (bool, error)
 	DeleteObject(ctx context.Context, bucketName, objectKey string) error
 	DeleteBucket(ctx context.Context, bucketName string) error
 	ListObjects(ctx context.Context, bucketName, prefix string) ([]string, error)
+	ListObjectsAfter(ctx context.Context, bucketName, prefix, marker string) ([]string, error)
 	ListBuckets(ctx context.Context) ([]string, error)
 	ListPrefix(ctx context.Context, bucketName, prefix string) ([]string, error)
 }
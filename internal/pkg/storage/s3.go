@@ -19,12 +19,14 @@ package storage
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"io"
 	"math/rand"
 	"net/http"
@@ -117,6 +119,21 @@ func (s *s3Service) MkBucket(ctx context.Context, bucketName string) error {
 	return err
 }
 
+// BucketExists reports whether the bucket exists, the CreateBucket of some S3 services succeeds for the existing bucket.
+func (s *s3Service) BucketExists(ctx context.Context, bucketName string) (bool, error) {
+	_, err := s.client.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: &bucketName,
+	})
+	var notFound *types.NotFound
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (s *s3Service) HeadObjectFromAddress(ctx context.Context, address string) (bucket, object string, err error) {
 	return getBucketKeyFromS3(address)
 }
@@ -189,6 +206,30 @@ func (s *s3Service) ListObjects(ctx context.Context, bucketName, prefix string)
 	return keys, nil
 }
 
+// ListObjectsAfter lists one page of the objects after the marker key, the first page is listed by the empty marker.
+func (s *s3Service) ListObjectsAfter(ctx context.Context, bucketName, prefix, marker string) ([]string, error) {
+	var ptrPrefix, ptrMarker *string
+	if prefix != "" {
+		ptrPrefix = &prefix
+	}
+	if marker != "" {
+		ptrMarker = &marker
+	}
+	resp, err := s.client.ListObjects(ctx, &s3.ListObjectsInput{
+		Bucket: &bucketName,
+		Prefix: ptrPrefix,
+		Marker: ptrMarker,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, obj := range resp.Contents {
+		keys = append(keys, *obj.Key)
+	}
+	return keys, nil
+}
+
 func (s *s3Service) ListPrefix(ctx context.Context, bucketName, prefix string) ([]string, error) {
 	var ptrPrefix *string
 	if prefix != "" {